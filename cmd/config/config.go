@@ -3,8 +3,10 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
+	"github.com/DylanDevelops/tmpo/internal/currency"
 	"github.com/DylanDevelops/tmpo/internal/settings"
 	"github.com/DylanDevelops/tmpo/internal/ui"
 	"github.com/manifoldco/promptui"
@@ -13,10 +15,10 @@ import (
 
 func ConfigCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "config",
+		Use:     "config",
 		Aliases: []string{"settings", "preferences"},
-		Short: "Configure global tmpo settings",
-		Long:  `Set up global configuration for tmpo including currency, date/time format, and timezone.`,
+		Short:   "Configure global tmpo settings",
+		Long:    `Set up global configuration for tmpo including currency, date/time format, and timezone.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			ui.NewlineAbove()
 
@@ -34,6 +36,8 @@ func ConfigCmd() *cobra.Command {
 			// Show current settings
 			fmt.Println(ui.Bold("Current settings:"))
 			fmt.Printf("  Currency:    %s\n", ui.Muted(currentConfig.Currency))
+			fmt.Printf("  Reporting:   %s\n", ui.Muted(currentConfig.GetReportingCurrency()))
+			fmt.Printf("  Tax rate:    %s\n", ui.Muted(formatTaxRateDisplay(currentConfig.TaxRate)))
 
 			dateFormatDisplay := "(default)"
 			if currentConfig.DateFormat != "" {
@@ -47,6 +51,12 @@ func ConfigCmd() *cobra.Command {
 			}
 			fmt.Printf("  Time format: %s\n", ui.Muted(timeFormatDisplay))
 
+			numberFormatDisplay := "(default)"
+			if currentConfig.Locale != "" {
+				numberFormatDisplay = currentConfig.Locale
+			}
+			fmt.Printf("  Numbers:     %s\n", ui.Muted(fmt.Sprintf("%s, e.g. %s", numberFormatDisplay, settings.FormatCurrency(1234.56, currentConfig.Currency))))
+
 			timezoneDisplay := "(local)"
 			if currentConfig.Timezone != "" {
 				timezoneDisplay = currentConfig.Timezone
@@ -58,6 +68,8 @@ func ConfigCmd() *cobra.Command {
 				exportPathDisplay = currentConfig.ExportPath
 			}
 			fmt.Printf("  Export path: %s\n", ui.Muted(exportPathDisplay))
+			fmt.Printf("  Daily goal:  %s\n", ui.Muted(formatGoalDisplay(currentConfig.DailyGoalHours)))
+			fmt.Printf("  Weekly goal: %s\n", ui.Muted(formatGoalDisplay(currentConfig.WeeklyGoalHours)))
 			fmt.Println()
 
 			// Currency prompt
@@ -77,6 +89,53 @@ func ConfigCmd() *cobra.Command {
 				currencyCode = currentConfig.Currency
 			}
 
+			// Reporting currency prompt
+			fmt.Println()
+			reportingDefault := currencyCode
+			if currentConfig.ReportingCurrency != "" {
+				reportingDefault = currentConfig.ReportingCurrency
+			}
+
+			fmt.Println(ui.Muted("Stats convert earnings in other currencies to the reporting currency"))
+			reportingPrompt := promptui.Prompt{
+				Label:    fmt.Sprintf("Reporting currency (press Enter for %s)", reportingDefault),
+				Validate: validateCurrency,
+			}
+
+			reportingInput, err := reportingPrompt.Run()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			reportingCurrency := strings.ToUpper(strings.TrimSpace(reportingInput))
+			if reportingCurrency == "" {
+				reportingCurrency = reportingDefault
+			}
+			// only store a reporting currency that differs from the currency
+			if reportingCurrency == currencyCode {
+				reportingCurrency = ""
+			}
+
+			// Tax rate prompt
+			fmt.Println()
+			fmt.Println(ui.Muted("Stats and exports add tax to earnings of projects without their own tax rate (enter 0 to disable)"))
+			taxRatePrompt := promptui.Prompt{
+				Label:    "Tax rate in % (press Enter to keep current)",
+				Validate: validateTaxRate,
+			}
+
+			taxRateInput, err := taxRatePrompt.Run()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			taxRate := currentConfig.TaxRate
+			if taxRateInput = strings.TrimSpace(taxRateInput); taxRateInput != "" {
+				taxRate, _ = strconv.ParseFloat(strings.TrimSuffix(taxRateInput, "%"), 64)
+			}
+
 			// Date format selection
 			fmt.Println()
 			dateFormatOptions := []string{"Keep current", "MM/DD/YYYY", "DD/MM/YYYY", "YYYY-MM-DD"}
@@ -115,6 +174,39 @@ func ConfigCmd() *cobra.Command {
 				timeFormat = currentConfig.TimeFormat
 			}
 
+			// Number format selection
+			fmt.Println()
+			numberFormatOptions := []string{"Keep current", fmt.Sprintf("Default (%s)", currency.FormatCurrency(1234.56, currencyCode))}
+			locales := currency.GetLocales()
+			for _, locale := range locales {
+				format, _ := currency.LocaleFormat(locale)
+				numberFormatOptions = append(numberFormatOptions, fmt.Sprintf("%s (%s)", locale, format.FormatCurrency(1234.56, currencyCode)))
+			}
+			numberFormatSelect := promptui.Select{
+				Label: "Select number format",
+				Items: numberFormatOptions,
+				Size:  10,
+			}
+
+			numberFormatIndex, _, err := numberFormatSelect.Run()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			// choosing a format replaces any separators set in the config file
+			locale := currentConfig.Locale
+			decimalSeparator := currentConfig.DecimalSeparator
+			thousandsSeparator := currentConfig.ThousandsSeparator
+			symbolPosition := currentConfig.SymbolPosition
+			if numberFormatIndex > 0 {
+				locale = ""
+				if numberFormatIndex > 1 {
+					locale = locales[numberFormatIndex-2]
+				}
+				decimalSeparator, thousandsSeparator, symbolPosition = "", "", ""
+			}
+
 			// Timezone prompt with validation
 			fmt.Println()
 			fmt.Println(ui.Muted("IANA timezone (e.g., America/New_York, Europe/London, Asia/Tokyo, UTC)"))
@@ -157,13 +249,30 @@ func ConfigCmd() *cobra.Command {
 				exportPath = currentConfig.ExportPath
 			}
 
+			// Working-hours goal prompts
+			fmt.Println()
+			fmt.Println(ui.Muted("Working-hours goals are shown in 'tmpo status' and 'tmpo stats' (enter 0 to disable)"))
+			dailyGoal := promptGoalHours("Daily goal in hours (press Enter to keep current)", currentConfig.DailyGoalHours)
+			weeklyGoal := promptGoalHours("Weekly goal in hours (press Enter to keep current)", currentConfig.WeeklyGoalHours)
+
 			// Create new config with updated values
 			newConfig := &settings.GlobalConfig{
-				Currency:   currencyCode,
-				DateFormat: dateFormat,
-				TimeFormat: timeFormat,
-				Timezone:   timezone,
-				ExportPath: exportPath,
+				Currency:           currencyCode,
+				ReportingCurrency:  reportingCurrency,
+				TaxRate:            taxRate,
+				Locale:             locale,
+				DecimalSeparator:   decimalSeparator,
+				ThousandsSeparator: thousandsSeparator,
+				SymbolPosition:     symbolPosition,
+				DateFormat:         dateFormat,
+				TimeFormat:         timeFormat,
+				Timezone:           timezone,
+				ExportPath:         exportPath,
+				DailyGoalHours:     dailyGoal,
+				WeeklyGoalHours:    weeklyGoal,
+				Pomodoro:           currentConfig.Pomodoro,
+				ProjectPaths:       currentConfig.ProjectPaths,
+				DetectByGitRemote:  currentConfig.DetectByGitRemote,
 			}
 
 			// Save the config
@@ -178,6 +287,14 @@ func ConfigCmd() *cobra.Command {
 			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Configuration saved to %s", ui.Muted(configPath)))
 			ui.PrintInfo(4, ui.Bold("Currency"), currencyCode)
 
+			if reportingCurrency != "" {
+				ui.PrintInfo(4, ui.Bold("Reporting currency"), reportingCurrency)
+			}
+
+			if taxRate > 0 {
+				ui.PrintInfo(4, ui.Bold("Tax rate"), formatTaxRateDisplay(taxRate))
+			}
+
 			if dateFormat != "" {
 				ui.PrintInfo(4, ui.Bold("Date format"), dateFormat)
 			}
@@ -186,6 +303,8 @@ func ConfigCmd() *cobra.Command {
 				ui.PrintInfo(4, ui.Bold("Time format"), timeFormat)
 			}
 
+			ui.PrintInfo(4, ui.Bold("Number format"), newConfig.CurrencyFormat().FormatCurrency(1234.56, currencyCode))
+
 			if timezone != "" {
 				ui.PrintInfo(4, ui.Bold("Timezone"), timezone)
 			}
@@ -196,6 +315,14 @@ func ConfigCmd() *cobra.Command {
 			}
 			ui.PrintInfo(4, ui.Bold("Export path"), exportPathDisplay)
 
+			if dailyGoal > 0 {
+				ui.PrintInfo(4, ui.Bold("Daily goal"), formatGoalDisplay(dailyGoal))
+			}
+
+			if weeklyGoal > 0 {
+				ui.PrintInfo(4, ui.Bold("Weekly goal"), formatGoalDisplay(weeklyGoal))
+			}
+
 			ui.NewlineBelow()
 		},
 	}
@@ -221,6 +348,10 @@ func validateCurrency(input string) error {
 		}
 	}
 
+	if !currency.IsSupported(input) {
+		return fmt.Errorf("unknown ISO 4217 currency code: %s", strings.ToUpper(input))
+	}
+
 	return nil
 }
 
@@ -254,3 +385,76 @@ func validateTimezone(input string) error {
 
 	return nil
 }
+
+func promptGoalHours(label string, current float64) float64 {
+	goalPrompt := promptui.Prompt{
+		Label:    label,
+		Validate: validateGoalHours,
+	}
+
+	goalInput, err := goalPrompt.Run()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	goalInput = strings.TrimSpace(goalInput)
+	if goalInput == "" {
+		return current
+	}
+
+	goal, _ := strconv.ParseFloat(goalInput, 64)
+	return goal
+}
+
+func validateGoalHours(input string) error {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil // keep current
+	}
+
+	hours, err := strconv.ParseFloat(input, 64)
+	if err != nil {
+		return fmt.Errorf("must be a valid number of hours")
+	}
+
+	if hours < 0 {
+		return fmt.Errorf("goal cannot be negative")
+	}
+
+	return nil
+}
+
+func validateTaxRate(input string) error {
+	input = strings.TrimSuffix(strings.TrimSpace(input), "%")
+	if input == "" {
+		return nil // keep current
+	}
+
+	rate, err := strconv.ParseFloat(input, 64)
+	if err != nil {
+		return fmt.Errorf("must be a valid percentage")
+	}
+
+	if rate < 0 || rate > 100 {
+		return fmt.Errorf("tax rate must be between 0 and 100")
+	}
+
+	return nil
+}
+
+func formatTaxRateDisplay(rate float64) string {
+	if rate <= 0 {
+		return "(none)"
+	}
+
+	return fmt.Sprintf("%g%%", rate)
+}
+
+func formatGoalDisplay(hours float64) string {
+	if hours <= 0 {
+		return "(none)"
+	}
+
+	return fmt.Sprintf("%gh", hours)
+}
@@ -0,0 +1,108 @@
+package entries
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	bulkDeleteFilter entryFilterFlags
+	bulkDeleteYes    bool
+)
+
+func BulkDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete every entry matching a filter",
+		Long: `Delete the entries matching a filter, along with their linked commits.
+
+The matching entries are listed for review before anything is deleted, and all
+of them are deleted in a single transaction.
+
+  tmpo entries delete --filter project=scratch --filter range=2026-10-01..2026-10-07`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			globalCfg, err := settings.LoadGlobalConfig()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("loading config: %v", err))
+				os.Exit(1)
+			}
+
+			_, dateFormatLayout := getDateFormatInfo(globalCfg.DateFormat)
+
+			filter, err := bulkDeleteFilter.build(dateFormatLayout)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			entries, err := db.FindEntries(filter)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if len(entries) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, "No entries match the filter.")
+				ui.NewlineBelow()
+				return
+			}
+
+			ui.PrintWarning(ui.EmojiWarning, fmt.Sprintf("You are about to delete %d entries:", len(entries)))
+			fmt.Println()
+
+			printEntryTable(entries)
+			fmt.Println()
+
+			if !bulkDeleteYes {
+				confirmPrompt := promptui.Select{
+					Label: fmt.Sprintf("Delete these %d entries?", len(entries)),
+					Items: []string{"No", "Yes"},
+				}
+
+				_, result, err := confirmPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if result == "No" {
+					ui.PrintWarning(ui.EmojiWarning, "Deletion cancelled")
+					ui.NewlineBelow()
+					os.Exit(0)
+				}
+			}
+
+			deleted, err := db.DeleteEntries(entryIDs(entries))
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				ui.PrintMuted(0, "No entries were deleted.")
+				os.Exit(1)
+			}
+
+			fmt.Println()
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Deleted %d entries", deleted))
+			ui.NewlineBelow()
+		},
+	}
+
+	bulkDeleteFilter.register(cmd)
+	cmd.Flags().BoolVarP(&bulkDeleteYes, "yes", "y", false, "Delete without asking for confirmation")
+
+	return cmd
+}
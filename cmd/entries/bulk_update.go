@@ -0,0 +1,155 @@
+package entries
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	bulkUpdateFilter    entryFilterFlags
+	bulkUpdateProject   string
+	bulkUpdateMilestone string
+	bulkUpdateBillable  bool
+	bulkUpdateYes       bool
+)
+
+func BulkUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update every entry matching a filter",
+		Long: `Move the entries matching a filter to another project or milestone, or
+mark them as billable or non-billable.
+
+The matching entries are listed for review before anything changes, and all of
+them are updated in a single transaction. When entries move to another project
+they keep their milestone if that project has one with the same name.
+
+  tmpo entries update --project old --range last-week --set-project new --set-milestone "Sprint 4"`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			setProject := cmd.Flags().Changed("set-project")
+			setMilestone := cmd.Flags().Changed("set-milestone")
+			setBillable := cmd.Flags().Changed("set-billable")
+			if !setProject && !setMilestone && !setBillable {
+				ui.PrintError(ui.EmojiError, "nothing to update, use --set-project, --set-milestone or --set-billable")
+				os.Exit(1)
+			}
+
+			var update storage.EntryUpdate
+			if setProject {
+				project := strings.TrimSpace(bulkUpdateProject)
+				if project == "" {
+					ui.PrintError(ui.EmojiError, "--set-project cannot be empty")
+					os.Exit(1)
+				}
+				update.Project = &project
+			}
+			if setMilestone {
+				milestone := strings.TrimSpace(bulkUpdateMilestone)
+				update.Milestone = &milestone
+			}
+			if setBillable {
+				update.Billable = &bulkUpdateBillable
+			}
+
+			globalCfg, err := settings.LoadGlobalConfig()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("loading config: %v", err))
+				os.Exit(1)
+			}
+
+			_, dateFormatLayout := getDateFormatInfo(globalCfg.DateFormat)
+
+			filter, err := bulkUpdateFilter.build(dateFormatLayout)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			entries, err := db.FindEntries(filter)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if len(entries) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, "No entries match the filter.")
+				ui.NewlineBelow()
+				return
+			}
+
+			ui.PrintSuccess("✏️", fmt.Sprintf("Update %d entries", len(entries)))
+			if update.Project != nil {
+				ui.PrintInfo(4, ui.Bold("Set Project"), *update.Project)
+			}
+			if update.Milestone != nil {
+				milestone := *update.Milestone
+				if milestone == "" {
+					milestone = "(None)"
+				}
+				ui.PrintInfo(4, ui.Bold("Set Milestone"), milestone)
+			}
+			if update.Billable != nil {
+				ui.PrintInfo(4, ui.Bold("Set Billable"), formatYesNo(*update.Billable))
+			}
+			fmt.Println()
+
+			printEntryTable(entries)
+			fmt.Println()
+
+			if !bulkUpdateYes {
+				confirmPrompt := promptui.Select{
+					Label: fmt.Sprintf("Update these %d entries?", len(entries)),
+					Items: []string{"No", "Yes"},
+				}
+
+				_, result, err := confirmPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if result == "No" {
+					ui.PrintWarning(ui.EmojiWarning, "Update cancelled")
+					ui.NewlineBelow()
+					os.Exit(0)
+				}
+			}
+
+			updated, err := db.UpdateEntries(entryIDs(entries), update)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				ui.PrintMuted(0, "No entries were changed.")
+				os.Exit(1)
+			}
+
+			fmt.Println()
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Updated %d entries", updated))
+			ui.NewlineBelow()
+		},
+	}
+
+	bulkUpdateFilter.register(cmd)
+	cmd.Flags().StringVar(&bulkUpdateProject, "set-project", "", "Move the entries to this project")
+	cmd.Flags().StringVar(&bulkUpdateMilestone, "set-milestone", "", "Assign the entries to this milestone (\"\" to remove)")
+	cmd.Flags().BoolVar(&bulkUpdateBillable, "set-billable", false, "Mark the entries as billable (--set-billable=false for non-billable)")
+	cmd.Flags().BoolVarP(&bulkUpdateYes, "yes", "y", false, "Update without asking for confirmation")
+
+	return cmd
+}
@@ -4,21 +4,29 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/DylanDevelops/tmpo/internal/settings"
 	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/settings"
 	"github.com/DylanDevelops/tmpo/internal/storage"
 	"github.com/DylanDevelops/tmpo/internal/ui"
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
 )
 
-var showAllProjectsDelete bool
+var (
+	showAllProjectsDelete bool
+	deleteYes             bool
+)
 
 func DeleteCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delete",
+		Use:   "delete [id]",
 		Short: "Delete a time entry",
-		Long:  `Delete a time entry using an interactive menu.`,
+		Long: `Delete a time entry using an interactive menu.
+
+Pass an entry ID to skip the entry selection, and --yes to skip the confirmation:
+
+  tmpo delete 42 --yes`,
+		Args: cobra.MaximumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			ui.NewlineAbove()
 			ui.PrintSuccess("🗑️", "Delete Time Entry")
@@ -31,94 +39,103 @@ func DeleteCmd() *cobra.Command {
 			}
 			defer db.Close()
 
-			var entries []*storage.TimeEntry
-			var projectName string
-
-			if showAllProjectsDelete {
-				// Show project selection first
-				projects, err := db.GetAllProjects()
+			var selectedEntry *storage.TimeEntry
+			if len(args) > 0 {
+				selectedEntry, err = getEntryArg(db, args[0])
 				if err != nil {
 					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
 					os.Exit(1)
 				}
-
-				if len(projects) == 0 {
-					ui.PrintError(ui.EmojiError, "No time entries found")
-					ui.NewlineBelow()
-					os.Exit(1)
-				}
-
-				projectPrompt := promptui.Select{
-					Label: "Select project",
-					Items: projects,
+			} else {
+				var entries []*storage.TimeEntry
+				var projectName string
+
+				if showAllProjectsDelete {
+					// Show project selection first
+					projects, err := db.GetAllProjects()
+					if err != nil {
+						ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+						os.Exit(1)
+					}
+
+					if len(projects) == 0 {
+						ui.PrintError(ui.EmojiError, "No time entries found")
+						ui.NewlineBelow()
+						os.Exit(1)
+					}
+
+					projectPrompt := promptui.Select{
+						Label: "Select project",
+						Items: projects,
+					}
+
+					_, selectedProject, err := projectPrompt.Run()
+					if err != nil {
+						ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+						os.Exit(1)
+					}
+
+					projectName = selectedProject
+				} else {
+					// Use current project
+					detectedProject, err := project.DetectConfiguredProject()
+					if err != nil {
+						ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+						os.Exit(1)
+					}
+					projectName = detectedProject
 				}
 
-				_, selectedProject, err := projectPrompt.Run()
+				// Get all entries for the selected/detected project
+				entries, err = db.GetEntriesByProject(projectName)
 				if err != nil {
 					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
 					os.Exit(1)
 				}
 
-				projectName = selectedProject
-			} else {
-				// Use current project
-				detectedProject, err := project.DetectConfiguredProject()
-				if err != nil {
-					ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+				if len(entries) == 0 {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("No time entries found for project '%s'", projectName))
+					if !showAllProjectsDelete {
+						ui.PrintMuted(0, "Use 'tmpo delete --show-all-projects' to see entries from all projects")
+					}
+					ui.NewlineBelow()
 					os.Exit(1)
 				}
-				projectName = detectedProject
-			}
-
-			// Get all entries for the selected/detected project
-			entries, err = db.GetEntriesByProject(projectName)
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
 
-			if len(entries) == 0 {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("No time entries found for project '%s'", projectName))
-				if !showAllProjectsDelete {
-					ui.PrintMuted(0, "Use 'tmpo delete --show-all-projects' to see entries from all projects")
+				// Format entries for selection
+				templates := &promptui.SelectTemplates{
+					Label:    "{{ . }}",
+					Active:   "▸ {{ .Label }}",
+					Inactive: "  {{ .Label }}",
+					Selected: "{{ .Label }}",
 				}
-				ui.NewlineBelow()
-				os.Exit(1)
-			}
 
-			// Format entries for selection
-			templates := &promptui.SelectTemplates{
-				Label:    "{{ . }}",
-				Active:   "▸ {{ .Label }}",
-				Inactive: "  {{ .Label }}",
-				Selected: "{{ .Label }}",
-			}
+				type entryItem struct {
+					Label string
+					Entry *storage.TimeEntry
+				}
 
-			type entryItem struct {
-				Label string
-				Entry *storage.TimeEntry
-			}
+				var items []entryItem
+				for _, entry := range entries {
+					label := formatEntryLabelForDelete(entry)
+					items = append(items, entryItem{Label: label, Entry: entry})
+				}
 
-			var items []entryItem
-			for _, entry := range entries {
-				label := formatEntryLabelForDelete(entry)
-				items = append(items, entryItem{Label: label, Entry: entry})
-			}
+				entryPrompt := promptui.Select{
+					Label:     "Select entry to delete",
+					Items:     items,
+					Templates: templates,
+				}
 
-			entryPrompt := promptui.Select{
-				Label:     "Select entry to delete",
-				Items:     items,
-				Templates: templates,
-			}
+				idx, _, err := entryPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
 
-			idx, _, err := entryPrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
+				selectedEntry = items[idx].Entry
 			}
 
-			selectedEntry := items[idx].Entry
-
 			// Show entry details and confirmation
 			fmt.Println()
 			ui.PrintWarning(ui.EmojiWarning, "You are about to delete this entry:")
@@ -138,21 +155,23 @@ func DeleteCmd() *cobra.Command {
 			fmt.Println()
 
 			// Confirm deletion
-			confirmPrompt := promptui.Select{
-				Label: "Are you sure you want to delete this entry?",
-				Items: []string{"No", "Yes"},
-			}
+			if !deleteYes {
+				confirmPrompt := promptui.Select{
+					Label: "Are you sure you want to delete this entry?",
+					Items: []string{"No", "Yes"},
+				}
 
-			_, result, err := confirmPrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
+				_, result, err := confirmPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
 
-			if result == "No" {
-				ui.PrintWarning(ui.EmojiWarning, "Deletion cancelled")
-				ui.NewlineBelow()
-				os.Exit(0)
+				if result == "No" {
+					ui.PrintWarning(ui.EmojiWarning, "Deletion cancelled")
+					ui.NewlineBelow()
+					os.Exit(0)
+				}
 			}
 
 			// Delete from database
@@ -168,6 +187,7 @@ func DeleteCmd() *cobra.Command {
 	}
 
 	cmd.Flags().BoolVar(&showAllProjectsDelete, "show-all-projects", false, "Show project selection before entry selection")
+	cmd.Flags().BoolVarP(&deleteYes, "yes", "y", false, "Delete without asking for confirmation")
 
 	return cmd
 }
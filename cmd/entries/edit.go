@@ -1,8 +1,11 @@
 package entries
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,15 +18,28 @@ import (
 )
 
 var (
-	showAllProjects bool
-	editProjectFlag string
+	showAllProjects   bool
+	editProjectFlag   string
+	editStartFlag     string
+	editEndFlag       string
+	editDescription   string
+	editMilestoneFlag string
+	editBillable      bool
+	editNonBillable   bool
 )
 
 func EditCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "edit",
+		Use:   "edit [id]",
 		Short: "Edit an existing time entry",
-		Long:  `Edit an existing time entry using an interactive menu.`,
+		Long: `Edit an existing time entry using an interactive menu.
+
+Pass an entry ID to skip the entry selection. Combined with --start, --end,
+--description, --milestone, --billable or --non-billable, the changes are
+saved without prompting:
+
+  tmpo edit 42 --start "2026-10-17 9:00" --end 12:30 --description "Planning"`,
+		Args: cobra.MaximumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			ui.NewlineAbove()
 			ui.PrintSuccess("✏️", "Edit Time Entry")
@@ -46,314 +62,131 @@ func EditCmd() *cobra.Command {
 			}
 			defer db.Close()
 
-			var entries []*storage.TimeEntry
-			var projectName string
+			// flags describe the changes, so there's nothing to prompt for
+			nonInteractive := cmd.Flags().Changed("start") || cmd.Flags().Changed("end") ||
+				cmd.Flags().Changed("description") || cmd.Flags().Changed("milestone") ||
+				editBillable || editNonBillable
 
-			if showAllProjects {
-				// Show project selection first
-				projects, err := db.GetProjectsWithCompletedEntries()
+			var selectedEntry *storage.TimeEntry
+			if len(args) > 0 {
+				selectedEntry, err = getEntryArg(db, args[0])
 				if err != nil {
 					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
 					os.Exit(1)
 				}
 
-				if len(projects) == 0 {
-					ui.PrintError(ui.EmojiError, "No completed time entries found")
+				if selectedEntry.IsRunning() {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("entry #%d is still running", selectedEntry.ID))
+					ui.PrintMuted(0, "Use 'tmpo stop' to stop it before editing.")
 					ui.NewlineBelow()
 					os.Exit(1)
 				}
+			} else if nonInteractive {
+				ui.PrintError(ui.EmojiError, "an entry ID is required when using --start, --end, --description or --milestone")
+				ui.PrintMuted(0, "Use 'tmpo log' to find the ID of the entry.")
+				ui.NewlineBelow()
+				os.Exit(1)
+			} else {
+				var entries []*storage.TimeEntry
+				var projectName string
+
+				if showAllProjects {
+					// Show project selection first
+					projects, err := db.GetProjectsWithCompletedEntries()
+					if err != nil {
+						ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+						os.Exit(1)
+					}
+
+					if len(projects) == 0 {
+						ui.PrintError(ui.EmojiError, "No completed time entries found")
+						ui.NewlineBelow()
+						os.Exit(1)
+					}
 
-				projectPrompt := promptui.Select{
-					Label: "Select project",
-					Items: projects,
+					projectPrompt := promptui.Select{
+						Label: "Select project",
+						Items: projects,
+					}
+
+					_, selectedProject, err := projectPrompt.Run()
+					if err != nil {
+						ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+						os.Exit(1)
+					}
+
+					projectName = selectedProject
+				} else {
+					// use current project or explicit project using --project flag
+					detectedProject, err := project.DetectConfiguredProjectWithOverride(editProjectFlag)
+					if err != nil {
+						ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+						os.Exit(1)
+					}
+					projectName = detectedProject
 				}
 
-				_, selectedProject, err := projectPrompt.Run()
+				// Get completed entries for the selected/detected project
+				entries, err = db.GetCompletedEntriesByProject(projectName)
 				if err != nil {
 					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
 					os.Exit(1)
 				}
 
-				projectName = selectedProject
-			} else {
-				// use current project or explicit project using --project flag
-				detectedProject, err := project.DetectConfiguredProjectWithOverride(editProjectFlag)
-				if err != nil {
-					ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+				if len(entries) == 0 {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("No completed time entries found for project '%s'", projectName))
+					if !showAllProjects {
+						ui.PrintMuted(0, "Use 'tmpo edit --show-all-projects' to see entries from all projects")
+					}
+					ui.NewlineBelow()
 					os.Exit(1)
 				}
-				projectName = detectedProject
-			}
-
-			// Get completed entries for the selected/detected project
-			entries, err = db.GetCompletedEntriesByProject(projectName)
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
 
-			if len(entries) == 0 {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("No completed time entries found for project '%s'", projectName))
-				if !showAllProjects {
-					ui.PrintMuted(0, "Use 'tmpo edit --show-all-projects' to see entries from all projects")
+				// Format entries for selection
+				templates := &promptui.SelectTemplates{
+					Label:    "{{ . }}",
+					Active:   "▸ {{ .Label }}",
+					Inactive: "  {{ .Label }}",
+					Selected: "{{ .Label }}",
 				}
-				ui.NewlineBelow()
-				os.Exit(1)
-			}
-
-			// Format entries for selection
-			templates := &promptui.SelectTemplates{
-				Label:    "{{ . }}",
-				Active:   "▸ {{ .Label }}",
-				Inactive: "  {{ .Label }}",
-				Selected: "{{ .Label }}",
-			}
-
-			type entryItem struct {
-				Label string
-				Entry *storage.TimeEntry
-			}
-
-			var items []entryItem
-			for _, entry := range entries {
-				label := formatEntryLabel(entry)
-				items = append(items, entryItem{Label: label, Entry: entry})
-			}
-
-			entryPrompt := promptui.Select{
-				Label:     "Select entry to edit",
-				Items:     items,
-				Templates: templates,
-			}
-
-			idx, _, err := entryPrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
-
-			selectedEntry := items[idx].Entry
-
-			editedEntry := &storage.TimeEntry{
-				ID:            selectedEntry.ID,
-				ProjectName:   selectedEntry.ProjectName,
-				StartTime:     selectedEntry.StartTime,
-				EndTime:       selectedEntry.EndTime,
-				Description:   selectedEntry.Description,
-				HourlyRate:    selectedEntry.HourlyRate,
-				MilestoneName: selectedEntry.MilestoneName,
-			}
-
-			// Edit start date
-			currentStartDate := settings.FormatDateDashed(selectedEntry.StartTime)
-			startDatePrompt := promptui.Prompt{
-				Label:     fmt.Sprintf("Start date (%s): (%s)", dateFormatDisplay, currentStartDate),
-				Validate:  func(input string) error { return validateDateOptional(input, dateFormatLayout, dateFormatDisplay) },
-				AllowEdit: true,
-			}
-
-			startDateInput, err := startDatePrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
-
-			startDateInput = strings.TrimSpace(startDateInput)
-			if startDateInput == "" {
-				startDateInput = currentStartDate
-			}
-
-			// Edit start time
-			currentStartTime := settings.FormatTime(selectedEntry.StartTime)
-			startTimePrompt := promptui.Prompt{
-				Label:     fmt.Sprintf("Start time (e.g., 9:30 AM or 14:30): (%s)", currentStartTime),
-				Validate:  validateTimeOptional,
-				AllowEdit: true,
-			}
-
-			startTimeInput, err := startTimePrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
 
-			startTimeInput = strings.TrimSpace(startTimeInput)
-			if startTimeInput == "" {
-				startTimeInput = currentStartTime
-			}
-
-			// Edit end date
-			currentEndDate := settings.FormatDateDashed(*selectedEntry.EndTime)
-			endDatePrompt := promptui.Prompt{
-				Label:     fmt.Sprintf("End date (%s): (%s)", dateFormatDisplay, currentEndDate),
-				Validate:  func(input string) error { return validateDateOptional(input, dateFormatLayout, dateFormatDisplay) },
-				AllowEdit: true,
-			}
-
-			endDateInput, err := endDatePrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
-
-			endDateInput = strings.TrimSpace(endDateInput)
-			if endDateInput == "" {
-				endDateInput = currentEndDate
-			}
-
-			// Edit end time
-			currentEndTime := settings.FormatTime(*selectedEntry.EndTime)
-			endTimePrompt := promptui.Prompt{
-				Label:     fmt.Sprintf("End time (e.g., 5:00 PM or 17:00): (%s)", currentEndTime),
-				Validate:  validateTimeOptional,
-				AllowEdit: true,
-			}
-
-			endTimeInput, err := endTimePrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
-
-			endTimeInput = strings.TrimSpace(endTimeInput)
-			if endTimeInput == "" {
-				endTimeInput = currentEndTime
-			}
-
-			// Validate that end is after start
-			if err := validateEndDateTime(startDateInput, startTimeInput, endDateInput, endTimeInput, dateFormatLayout); err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
-
-			// Edit description
-			currentDescription := selectedEntry.Description
-			descriptionLabel := "Description"
-			if currentDescription != "" {
-				descriptionLabel = fmt.Sprintf("Description: (%s)", currentDescription)
-			}
-			descriptionPrompt := promptui.Prompt{
-				Label:     descriptionLabel,
-				AllowEdit: true,
-			}
-
-			descriptionInput, err := descriptionPrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
-
-			descriptionInput = strings.TrimSpace(descriptionInput)
-			if descriptionInput == "" {
-				descriptionInput = currentDescription
-			}
-
-			// edit assignment of milestone
-			milestones, err := db.GetMilestonesByProject(projectName)
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
-
-			var newMilestoneName *string
-			if len(milestones) > 0 {
-				milestoneOptions := []string{"(None)"}
-				selectedIndex := 0
-
-				for i, m := range milestones {
-					status := "Active"
-					if !m.IsActive() {
-						status = "Finished"
-					}
-					milestoneOptions = append(milestoneOptions, fmt.Sprintf("%s (%s)", m.Name, status))
+				type entryItem struct {
+					Label string
+					Entry *storage.TimeEntry
+				}
 
-					// first select current milestone if match
-					if selectedEntry.MilestoneName != nil && m.Name == *selectedEntry.MilestoneName {
-						selectedIndex = i + 1
-					}
+				var items []entryItem
+				for _, entry := range entries {
+					label := formatEntryLabel(entry)
+					items = append(items, entryItem{Label: label, Entry: entry})
 				}
 
-				milestonePrompt := promptui.Select{
-					Label:     "Assign to milestone (optional)",
-					Items:     milestoneOptions,
-					CursorPos: selectedIndex,
+				entryPrompt := promptui.Select{
+					Label:     "Select entry to edit",
+					Items:     items,
+					Templates: templates,
 				}
 
-				milestoneIdx, _, err := milestonePrompt.Run()
+				idx, _, err := entryPrompt.Run()
 				if err != nil {
 					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
 					os.Exit(1)
 				}
 
-				// if not its not empty set the milestone
-				if milestoneIdx > 0 {
-					selectedMilestone := milestones[milestoneIdx-1]
-					newMilestoneName = &selectedMilestone.Name
-				}
-			}
-
-			// Parse the new times
-			newStartTime, err := parseDateTime(startDateInput, startTimeInput, dateFormatLayout)
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("parsing start time: %v", err))
-				os.Exit(1)
-			}
-
-			newEndTime, err := parseDateTime(endDateInput, endTimeInput, dateFormatLayout)
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("parsing end time: %v", err))
-				os.Exit(1)
+				selectedEntry = items[idx].Entry
 			}
 
-			editedEntry.StartTime = newStartTime
-			editedEntry.EndTime = &newEndTime
-			editedEntry.Description = descriptionInput
-			editedEntry.MilestoneName = newMilestoneName
-
-			// warn if outside of time range
-			if newMilestoneName != nil {
-				milestone, err := db.GetMilestoneByName(projectName, *newMilestoneName)
-				if err == nil && milestone != nil {
-					entryOutsideRange := false
-					warningMsg := ""
-
-					if newStartTime.Before(milestone.StartTime) {
-						entryOutsideRange = true
-						warningMsg = fmt.Sprintf("Entry starts (%s) before milestone began (%s)",
-							settings.FormatDateTimeDashed(newStartTime),
-							settings.FormatDateTimeDashed(milestone.StartTime))
-					} else if milestone.EndTime != nil && newStartTime.After(*milestone.EndTime) {
-						entryOutsideRange = true
-						warningMsg = fmt.Sprintf("Entry starts (%s) after milestone ended (%s)",
-							settings.FormatDateTimeDashed(newStartTime),
-							settings.FormatDateTimeDashed(*milestone.EndTime))
-					}
+			// copy the whole entry so fields without a prompt (tags, issue) are kept
+			edited := *selectedEntry
+			editedEntry := &edited
 
-					if entryOutsideRange {
-						fmt.Println()
-						ui.PrintWarning(ui.EmojiWarning, "Entry not within milestone timeframe")
-						ui.PrintMuted(0, warningMsg)
-						ui.PrintMuted(0, "This is allowed - milestones are organizational tags and work with any date range.")
-						fmt.Println()
-
-						confirmPrompt := promptui.Select{
-							Label: "Assign this entry to the milestone?",
-							Items: []string{"Yes", "No"},
-						}
-
-						_, result, err := confirmPrompt.Run()
-						if err != nil {
-							ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-							os.Exit(1)
-						}
-
-						if result == "No" {
-							ui.PrintWarning(ui.EmojiWarning, "Milestone assignment cancelled")
-							ui.NewlineBelow()
-							os.Exit(0)
-						}
-					}
+			if nonInteractive {
+				if err := applyEditFlags(cmd, db, editedEntry, dateFormatLayout, dateFormatDisplay); err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
 				}
+			} else {
+				promptEntryEdits(db, selectedEntry, editedEntry, dateFormatLayout, dateFormatDisplay)
 			}
 
 			// Show confirmation with diff
@@ -393,13 +226,18 @@ func EditCmd() *cobra.Command {
 			if selectedEntry.MilestoneName != nil {
 				oldMilestone = *selectedEntry.MilestoneName
 			}
-			newMilestone := "(None)"
+			newMilestoneLabel := "(None)"
 			if editedEntry.MilestoneName != nil {
-				newMilestone = *editedEntry.MilestoneName
+				newMilestoneLabel = *editedEntry.MilestoneName
 			}
-			if oldMilestone != newMilestone {
+			if oldMilestone != newMilestoneLabel {
 				hasChanges = true
-				fmt.Printf("    %s %s → %s\n", ui.Bold("Milestone:"), ui.Muted(oldMilestone), newMilestone)
+				fmt.Printf("    %s %s → %s\n", ui.Bold("Milestone:"), ui.Muted(oldMilestone), newMilestoneLabel)
+			}
+
+			if selectedEntry.Billable != editedEntry.Billable {
+				hasChanges = true
+				fmt.Printf("    %s %s → %s\n", ui.Bold("Billable:"), ui.Muted(formatYesNo(selectedEntry.Billable)), formatYesNo(editedEntry.Billable))
 			}
 
 			if !hasChanges {
@@ -408,23 +246,25 @@ func EditCmd() *cobra.Command {
 				os.Exit(0)
 			}
 
-			fmt.Println()
+			if !nonInteractive {
+				fmt.Println()
 
-			confirmPrompt := promptui.Select{
-				Label: "Save changes?",
-				Items: []string{"Yes", "No"},
-			}
+				confirmPrompt := promptui.Select{
+					Label: "Save changes?",
+					Items: []string{"Yes", "No"},
+				}
 
-			_, result, err := confirmPrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
+				_, result, err := confirmPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
 
-			if result == "No" {
-				ui.PrintWarning(ui.EmojiWarning, "Changes discarded")
-				ui.NewlineBelow()
-				os.Exit(0)
+				if result == "No" {
+					ui.PrintWarning(ui.EmojiWarning, "Changes discarded")
+					ui.NewlineBelow()
+					os.Exit(0)
+				}
 			}
 
 			// Save to database
@@ -441,10 +281,330 @@ func EditCmd() *cobra.Command {
 
 	cmd.Flags().BoolVar(&showAllProjects, "show-all-projects", false, "Show project selection before entry selection")
 	cmd.Flags().StringVarP(&editProjectFlag, "project", "p", "", "Edit entries for a specific global project")
+	cmd.Flags().StringVar(&editStartFlag, "start", "", "New start date and time, or just a time to keep the date")
+	cmd.Flags().StringVar(&editEndFlag, "end", "", "New end date and time, or just a time to keep the date")
+	cmd.Flags().StringVarP(&editDescription, "description", "d", "", "New description")
+	cmd.Flags().StringVarP(&editMilestoneFlag, "milestone", "m", "", "Assign to a milestone by name (\"\" to remove)")
+	cmd.Flags().BoolVar(&editBillable, "billable", false, "Mark the entry as billable")
+	cmd.Flags().BoolVar(&editNonBillable, "non-billable", false, "Mark the entry as non-billable")
+	cmd.MarkFlagsMutuallyExclusive("billable", "non-billable")
 
 	return cmd
 }
 
+// promptEntryEdits asks for each editable field of selectedEntry, pre-filled
+// with its current value, and applies the answers to editedEntry.
+func promptEntryEdits(db *storage.Database, selectedEntry, editedEntry *storage.TimeEntry, dateFormatLayout, dateFormatDisplay string) {
+	// Edit start date
+	currentStartDate := settings.FormatDateDashed(selectedEntry.StartTime)
+	startDatePrompt := promptui.Prompt{
+		Label:     fmt.Sprintf("Start date (%s): (%s)", dateFormatDisplay, currentStartDate),
+		Validate:  func(input string) error { return validateDateOptional(input, dateFormatLayout, dateFormatDisplay) },
+		AllowEdit: true,
+	}
+
+	startDateInput, err := startDatePrompt.Run()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	startDateInput = strings.TrimSpace(startDateInput)
+	if startDateInput == "" {
+		startDateInput = currentStartDate
+	}
+
+	// Edit start time
+	currentStartTime := settings.FormatTime(selectedEntry.StartTime)
+	startTimePrompt := promptui.Prompt{
+		Label:     fmt.Sprintf("Start time (e.g., 9:30 AM or 14:30): (%s)", currentStartTime),
+		Validate:  validateTimeOptional,
+		AllowEdit: true,
+	}
+
+	startTimeInput, err := startTimePrompt.Run()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	startTimeInput = strings.TrimSpace(startTimeInput)
+	if startTimeInput == "" {
+		startTimeInput = currentStartTime
+	}
+
+	// Edit end date
+	currentEndDate := settings.FormatDateDashed(*selectedEntry.EndTime)
+	endDatePrompt := promptui.Prompt{
+		Label:     fmt.Sprintf("End date (%s): (%s)", dateFormatDisplay, currentEndDate),
+		Validate:  func(input string) error { return validateDateOptional(input, dateFormatLayout, dateFormatDisplay) },
+		AllowEdit: true,
+	}
+
+	endDateInput, err := endDatePrompt.Run()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	endDateInput = strings.TrimSpace(endDateInput)
+	if endDateInput == "" {
+		endDateInput = currentEndDate
+	}
+
+	// Edit end time
+	currentEndTime := settings.FormatTime(*selectedEntry.EndTime)
+	endTimePrompt := promptui.Prompt{
+		Label:     fmt.Sprintf("End time (e.g., 5:00 PM or 17:00): (%s)", currentEndTime),
+		Validate:  validateTimeOptional,
+		AllowEdit: true,
+	}
+
+	endTimeInput, err := endTimePrompt.Run()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	endTimeInput = strings.TrimSpace(endTimeInput)
+	if endTimeInput == "" {
+		endTimeInput = currentEndTime
+	}
+
+	// Validate that end is after start
+	if err := validateEndDateTime(startDateInput, startTimeInput, endDateInput, endTimeInput, dateFormatLayout); err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	// Edit description
+	currentDescription := selectedEntry.Description
+	descriptionLabel := "Description"
+	if currentDescription != "" {
+		descriptionLabel = fmt.Sprintf("Description: (%s)", currentDescription)
+	}
+	descriptionPrompt := promptui.Prompt{
+		Label:     descriptionLabel,
+		AllowEdit: true,
+	}
+
+	descriptionInput, err := descriptionPrompt.Run()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	descriptionInput = strings.TrimSpace(descriptionInput)
+	if descriptionInput == "" {
+		descriptionInput = currentDescription
+	}
+
+	// edit assignment of milestone
+	milestones, err := db.GetMilestonesByProject(selectedEntry.ProjectName)
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	var newMilestone *storage.Milestone
+	if len(milestones) > 0 {
+		milestoneOptions := []string{"(None)"}
+		selectedIndex := 0
+
+		for i, m := range milestones {
+			status := "Active"
+			if !m.IsActive() {
+				status = "Finished"
+			}
+			milestoneOptions = append(milestoneOptions, fmt.Sprintf("%s (%s)", m.Name, status))
+
+			// first select current milestone if match
+			if selectedEntry.MilestoneID != nil && m.ID == *selectedEntry.MilestoneID {
+				selectedIndex = i + 1
+			}
+		}
+
+		milestonePrompt := promptui.Select{
+			Label:     "Assign to milestone (optional)",
+			Items:     milestoneOptions,
+			CursorPos: selectedIndex,
+		}
+
+		milestoneIdx, _, err := milestonePrompt.Run()
+		if err != nil {
+			ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+			os.Exit(1)
+		}
+
+		// if not its not empty set the milestone
+		if milestoneIdx > 0 {
+			newMilestone = milestones[milestoneIdx-1]
+		}
+	}
+
+	billableCursor := 0
+	if !selectedEntry.Billable {
+		billableCursor = 1
+	}
+
+	billablePrompt := promptui.Select{
+		Label:     "Billable?",
+		Items:     []string{"Yes", "No"},
+		CursorPos: billableCursor,
+	}
+
+	_, billableResult, err := billablePrompt.Run()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	// Parse the new times
+	newStartTime, err := parseDateTime(startDateInput, startTimeInput, dateFormatLayout)
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("parsing start time: %v", err))
+		os.Exit(1)
+	}
+
+	newEndTime, err := parseDateTime(endDateInput, endTimeInput, dateFormatLayout)
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("parsing end time: %v", err))
+		os.Exit(1)
+	}
+
+	editedEntry.StartTime = newStartTime
+	editedEntry.EndTime = &newEndTime
+	editedEntry.Description = descriptionInput
+	editedEntry.Billable = billableResult == "Yes"
+	editedEntry.MilestoneID = nil
+	editedEntry.MilestoneName = nil
+	if newMilestone != nil {
+		editedEntry.MilestoneID = &newMilestone.ID
+		editedEntry.MilestoneName = &newMilestone.Name
+	}
+
+	// warn if outside of time range
+	if newMilestone != nil {
+		milestone, err := db.GetMilestone(newMilestone.ID)
+		if err == nil && milestone != nil {
+			entryOutsideRange := false
+			warningMsg := ""
+
+			if newStartTime.Before(milestone.StartTime) {
+				entryOutsideRange = true
+				warningMsg = fmt.Sprintf("Entry starts (%s) before milestone began (%s)",
+					settings.FormatDateTimeDashed(newStartTime),
+					settings.FormatDateTimeDashed(milestone.StartTime))
+			} else if milestone.EndTime != nil && newStartTime.After(*milestone.EndTime) {
+				entryOutsideRange = true
+				warningMsg = fmt.Sprintf("Entry starts (%s) after milestone ended (%s)",
+					settings.FormatDateTimeDashed(newStartTime),
+					settings.FormatDateTimeDashed(*milestone.EndTime))
+			}
+
+			if entryOutsideRange {
+				fmt.Println()
+				ui.PrintWarning(ui.EmojiWarning, "Entry not within milestone timeframe")
+				ui.PrintMuted(0, warningMsg)
+				ui.PrintMuted(0, "This is allowed - milestones are organizational tags and work with any date range.")
+				fmt.Println()
+
+				confirmPrompt := promptui.Select{
+					Label: "Assign this entry to the milestone?",
+					Items: []string{"Yes", "No"},
+				}
+
+				_, result, err := confirmPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if result == "No" {
+					ui.PrintWarning(ui.EmojiWarning, "Milestone assignment cancelled")
+					ui.NewlineBelow()
+					os.Exit(0)
+				}
+			}
+		}
+	}
+}
+
+// applyEditFlags applies the --start, --end, --description, --milestone and
+// billable flags to entry, using the same validation as the interactive
+// prompts.
+func applyEditFlags(cmd *cobra.Command, db *storage.Database, entry *storage.TimeEntry, layout, displayFormat string) error {
+	if cmd.Flags().Changed("start") {
+		start, err := parseDateTimeFlag(editStartFlag, layout, displayFormat, &entry.StartTime)
+		if err != nil {
+			return fmt.Errorf("invalid --start: %w", err)
+		}
+		entry.StartTime = start
+	}
+
+	if cmd.Flags().Changed("end") {
+		// a bare time keeps the entry on its current end date
+		end, err := parseDateTimeFlag(editEndFlag, layout, displayFormat, entry.EndTime)
+		if err != nil {
+			return fmt.Errorf("invalid --end: %w", err)
+		}
+		entry.EndTime = &end
+	}
+
+	if err := validateEntryRange(entry.StartTime, *entry.EndTime); err != nil {
+		return err
+	}
+
+	if cmd.Flags().Changed("description") {
+		entry.Description = strings.TrimSpace(editDescription)
+	}
+
+	if cmd.Flags().Changed("milestone") {
+		entry.MilestoneID = nil
+		entry.MilestoneName = nil
+
+		if name := strings.TrimSpace(editMilestoneFlag); name != "" {
+			milestone, err := findMilestone(db, entry.ProjectName, name)
+			if err != nil {
+				return err
+			}
+			entry.MilestoneID = &milestone.ID
+			entry.MilestoneName = &milestone.Name
+		}
+	}
+
+	if editBillable {
+		entry.Billable = true
+	} else if editNonBillable {
+		entry.Billable = false
+	}
+
+	return nil
+}
+
+// getEntryArg loads the entry whose ID was passed as a command argument.
+func getEntryArg(db *storage.Database, arg string) (*storage.TimeEntry, error) {
+	id, err := strconv.ParseInt(strings.TrimPrefix(arg, "#"), 10, 64)
+	if err != nil || id <= 0 {
+		return nil, fmt.Errorf("invalid entry ID '%s'", arg)
+	}
+
+	entry, err := db.GetEntry(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("entry #%d not found", id)
+	}
+
+	return entry, err
+}
+
+func formatYesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+
+	return "no"
+}
+
 func formatEntryLabel(entry *storage.TimeEntry) string {
 	startStr := settings.FormatDateTimeDashed(entry.StartTime)
 	endStr := settings.FormatTime(*entry.EndTime)
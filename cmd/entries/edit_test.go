@@ -0,0 +1,42 @@
+package entries
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditKeepsFieldsWithoutPrompt(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("TMPO_DEV", "")
+
+	db, err := storage.Initialize()
+	require.NoError(t, err)
+
+	start := time.Date(2024, 1, 15, 9, 0, 0, 0, time.Local)
+	entry, err := db.CreateManualEntry("web", "Focus", start, start.Add(25*time.Minute), nil, nil, true)
+	require.NoError(t, err)
+	require.NoError(t, db.SetEntryTags(entry.ID, []string{"pomodoro", "deep-work"}))
+	require.NoError(t, db.SetEntryIssueKey(entry.ID, "WEB-42"))
+	require.NoError(t, db.SetEntryNotes(entry.ID, "Wrote the parser."))
+	require.NoError(t, db.Close())
+
+	cmd := EditCmd()
+	cmd.SetArgs([]string{strconv.FormatInt(entry.ID, 10), "--description", "Parser work"})
+	require.NoError(t, cmd.Execute())
+
+	db, err = storage.Initialize()
+	require.NoError(t, err)
+	defer db.Close()
+
+	edited, err := db.GetEntry(entry.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Parser work", edited.Description)
+	assert.Equal(t, []string{"pomodoro", "deep-work"}, edited.Tags)
+	assert.Equal(t, "WEB-42", edited.IssueKey)
+	assert.Equal(t, "Wrote the parser.", edited.Notes)
+}
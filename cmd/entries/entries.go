@@ -0,0 +1,18 @@
+package entries
+
+import "github.com/spf13/cobra"
+
+func EntryCmds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entries",
+		Short: "Bulk edit, split and merge time entries",
+		Long:  `Update or delete every time entry matching a filter, or split and merge individual entries.`,
+	}
+
+	cmd.AddCommand(BulkUpdateCmd())
+	cmd.AddCommand(BulkDeleteCmd())
+	cmd.AddCommand(SplitCmd())
+	cmd.AddCommand(MergeCmd())
+
+	return cmd
+}
@@ -0,0 +1,138 @@
+package entries
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// entryFilterFlags are the flags bulk commands use to select entries. Each
+// criterion can be given as its own flag or as --filter key=value.
+type entryFilterFlags struct {
+	project     string
+	milestone   string
+	tag         string
+	issue       string
+	description string
+	dateRange   string
+	filters     []string
+}
+
+func (f *entryFilterFlags) register(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&f.project, "project", "p", "", "Only entries of this project")
+	cmd.Flags().StringVarP(&f.milestone, "milestone", "m", "", "Only entries assigned to this milestone")
+	cmd.Flags().StringVarP(&f.tag, "tag", "t", "", "Only entries with this tag")
+	cmd.Flags().StringVar(&f.issue, "issue", "", "Only entries linked to this issue key")
+	cmd.Flags().StringVar(&f.description, "description", "", "Only entries whose description contains this text")
+	cmd.Flags().StringVarP(&f.dateRange, "range", "r", "", "Only entries started in this range (today, week, last-week, month, last-month, DATE or FROM..TO)")
+	cmd.Flags().StringArrayVar(&f.filters, "filter", nil, "Filter as key=value, where key is project, milestone, tag, issue, description or range (repeatable)")
+}
+
+// build turns the flags into a storage filter. It refuses to build an empty
+// filter so a bulk command can never match every entry by accident.
+func (f *entryFilterFlags) build(dateLayout string) (storage.EntryFilter, error) {
+	criteria := map[string]string{
+		"project":     f.project,
+		"milestone":   f.milestone,
+		"tag":         f.tag,
+		"issue":       f.issue,
+		"description": f.description,
+		"range":       f.dateRange,
+	}
+
+	for _, filter := range f.filters {
+		key, value, found := strings.Cut(filter, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		if !found {
+			return storage.EntryFilter{}, fmt.Errorf("invalid filter '%s', use key=value", filter)
+		}
+
+		existing, known := criteria[key]
+		if !known {
+			return storage.EntryFilter{}, fmt.Errorf("unknown filter '%s', use project, milestone, tag, issue, description or range", key)
+		}
+		if existing != "" {
+			return storage.EntryFilter{}, fmt.Errorf("%s is filtered more than once", key)
+		}
+
+		criteria[key] = strings.TrimSpace(value)
+	}
+
+	filter := storage.EntryFilter{
+		Project:     criteria["project"],
+		Milestone:   criteria["milestone"],
+		Tag:         criteria["tag"],
+		IssueKey:    criteria["issue"],
+		Description: criteria["description"],
+	}
+
+	if criteria["range"] != "" {
+		start, end, err := settings.ParseDateRange(criteria["range"], dateLayout, time.Now())
+		if err != nil {
+			return storage.EntryFilter{}, fmt.Errorf("invalid range: %w", err)
+		}
+		filter.Start = start
+		filter.End = end
+	}
+
+	if filter.IsEmpty() {
+		return storage.EntryFilter{}, fmt.Errorf("at least one filter is required (e.g. --project, --range or --filter)")
+	}
+
+	return filter, nil
+}
+
+// printEntryTable prints the entries a bulk command is about to change.
+func printEntryTable(entries []*storage.TimeEntry) {
+	fmt.Printf("  %s\n", ui.Muted(fmt.Sprintf("%-6s %-19s %-12s %-20s %-16s %s", "ID", "Start", "Duration", "Project", "Milestone", "Description")))
+
+	var total time.Duration
+	for _, entry := range entries {
+		duration := ui.FormatDuration(entry.Duration())
+		if entry.IsRunning() {
+			duration = "running"
+		}
+
+		milestone := ""
+		if entry.MilestoneName != nil {
+			milestone = *entry.MilestoneName
+		}
+
+		fmt.Printf("  %-6s %-19s %-12s %-20s %-16s %s\n",
+			fmt.Sprintf("#%d", entry.ID),
+			settings.FormatDateTimeDashed(entry.StartTime),
+			duration,
+			truncate(entry.ProjectName, 20),
+			truncate(milestone, 16),
+			truncate(entry.Description, 40))
+
+		total += entry.Duration()
+	}
+
+	fmt.Println()
+	ui.PrintInfo(4, ui.Bold("Entries"), fmt.Sprintf("%d", len(entries)))
+	ui.PrintInfo(4, ui.Bold("Total Time"), ui.FormatDuration(total))
+}
+
+func truncate(value string, width int) string {
+	if utf8.RuneCountInString(value) <= width {
+		return value
+	}
+
+	return string([]rune(value)[:width-1]) + "…"
+}
+
+func entryIDs(entries []*storage.TimeEntry) []int64 {
+	ids := make([]int64, len(entries))
+	for i, entry := range entries {
+		ids[i] = entry.ID
+	}
+
+	return ids
+}
@@ -0,0 +1,35 @@
+package entries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntryFilterFlagsBuild(t *testing.T) {
+	t.Run("combines flags and --filter", func(t *testing.T) {
+		flags := entryFilterFlags{project: "web", filters: []string{"tag=bug", "milestone = Sprint 1"}}
+		filter, err := flags.build("01-02-2006")
+		require.NoError(t, err)
+		assert.Equal(t, "web", filter.Project)
+		assert.Equal(t, "bug", filter.Tag)
+		assert.Equal(t, "Sprint 1", filter.Milestone)
+	})
+
+	t.Run("requires a filter", func(t *testing.T) {
+		_, err := (&entryFilterFlags{}).build("01-02-2006")
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects unknown and repeated keys", func(t *testing.T) {
+		_, err := (&entryFilterFlags{filters: []string{"client=acme"}}).build("01-02-2006")
+		assert.Error(t, err)
+
+		_, err = (&entryFilterFlags{project: "web", filters: []string{"project=api"}}).build("01-02-2006")
+		assert.Error(t, err)
+
+		_, err = (&entryFilterFlags{filters: []string{"project"}}).build("01-02-2006")
+		assert.Error(t, err)
+	})
+}
@@ -6,7 +6,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/DylanDevelops/tmpo/internal/currency"
 	"github.com/DylanDevelops/tmpo/internal/project"
 	"github.com/DylanDevelops/tmpo/internal/settings"
 	"github.com/DylanDevelops/tmpo/internal/storage"
@@ -16,7 +15,12 @@ import (
 )
 
 var (
-	manualProjectFlag string
+	manualProjectFlag   string
+	manualStartFlag     string
+	manualEndFlag       string
+	manualDescription   string
+	manualMilestoneFlag string
+	manualNonBillable   bool
 )
 
 func getDateFormatInfo(configFormat string) (displayFormat, layout string) {
@@ -36,7 +40,11 @@ func ManualCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "manual",
 		Short: "Create a manual time entry",
-		Long:  `Create a completed time entry by specifying start and end times using an interactive menu.`,
+		Long: `Create a completed time entry by specifying start and end times using an interactive menu.
+
+Pass --start and --end to create the entry without prompting, e.g. from scripts:
+
+  tmpo manual --project "Client Work" --start "2026-10-17 9:00" --end "2026-10-17 12:30" --description "Planning"`,
 		Run: func(cmd *cobra.Command, args []string) {
 			ui.NewlineAbove()
 			ui.PrintSuccess(ui.EmojiManual, "Create Manual Time Entry")
@@ -52,117 +60,161 @@ func ManualCmd() *cobra.Command {
 			// Get date format for prompts and validation
 			dateFormatDisplay, dateFormatLayout := getDateFormatInfo(globalCfg.DateFormat)
 
-			defaultProject := detectProjectNameWithSource(manualProjectFlag)
+			var projectName, description string
+			var startTime, endTime time.Time
+
+			// --start/--end skip the prompts so entries can be created from scripts
+			nonInteractive := cmd.Flags().Changed("start") || cmd.Flags().Changed("end")
+
+			if nonInteractive {
+				// like the project prompt, --project accepts any project name
+				projectName = strings.TrimSpace(manualProjectFlag)
+				if projectName == "" {
+					projectName = detectProjectNameWithSource("")
+				}
+				if projectName == "" {
+					ui.PrintError(ui.EmojiError, "could not detect a project, use --project")
+					os.Exit(1)
+				}
+
+				if manualStartFlag == "" || manualEndFlag == "" {
+					ui.PrintError(ui.EmojiError, "--start and --end must be used together")
+					os.Exit(1)
+				}
 
-			var projectLabel string
-			if defaultProject != "" {
-				projectLabel = fmt.Sprintf("Project name: (%s)", defaultProject)
+				startTime, err = parseDateTimeFlag(manualStartFlag, dateFormatLayout, dateFormatDisplay, nil)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid --start: %v", err))
+					os.Exit(1)
+				}
+
+				// a bare time in --end means the same day as --start
+				endTime, err = parseDateTimeFlag(manualEndFlag, dateFormatLayout, dateFormatDisplay, &startTime)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid --end: %v", err))
+					os.Exit(1)
+				}
+
+				if err := validateEntryRange(startTime, endTime); err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				description = manualDescription
 			} else {
-				projectLabel = "Project name"
-			}
+				defaultProject := detectProjectNameWithSource(manualProjectFlag)
 
-			projectPrompt := promptui.Prompt{
-				Label: projectLabel,
-				AllowEdit: true,
-			}
+				var projectLabel string
+				if defaultProject != "" {
+					projectLabel = fmt.Sprintf("Project name: (%s)", defaultProject)
+				} else {
+					projectLabel = "Project name"
+				}
 
-			projectInput, err := projectPrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
+				projectPrompt := promptui.Prompt{
+					Label:     projectLabel,
+					AllowEdit: true,
+				}
 
-			projectName := strings.TrimSpace(projectInput)
-			if projectName == "" {
-				projectName = defaultProject
-			}
+				projectInput, err := projectPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
 
-			if projectName == "" {
-				ui.PrintError(ui.EmojiError, "project name cannot be empty")
-				os.Exit(1)
-			}
+				projectName = strings.TrimSpace(projectInput)
+				if projectName == "" {
+					projectName = defaultProject
+				}
 
-			startDatePrompt := promptui.Prompt{
-				Label:    fmt.Sprintf("Start date (%s)", dateFormatDisplay),
-				Validate: func(input string) error { return validateDate(input, dateFormatLayout, dateFormatDisplay) },
-			}
+				if projectName == "" {
+					ui.PrintError(ui.EmojiError, "project name cannot be empty")
+					os.Exit(1)
+				}
 
-			startDateInput, err := startDatePrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
+				startDatePrompt := promptui.Prompt{
+					Label:    fmt.Sprintf("Start date (%s)", dateFormatDisplay),
+					Validate: func(input string) error { return validateDate(input, dateFormatLayout, dateFormatDisplay) },
+				}
 
-			startTimePrompt := promptui.Prompt{
-				Label:    "Start time (e.g., 9:30 AM or 14:30)",
-				Validate: validateTime,
-			}
+				startDateInput, err := startDatePrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
 
-			startTimeStr, err := startTimePrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
+				startTimePrompt := promptui.Prompt{
+					Label:    "Start time (e.g., 9:30 AM or 14:30)",
+					Validate: validateTime,
+				}
 
-			endDateLabel := fmt.Sprintf("End date (%s): (%s)", dateFormatDisplay, startDateInput)
+				startTimeStr, err := startTimePrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
 
-			endDatePrompt := promptui.Prompt{
-				Label:     endDateLabel,
-				AllowEdit: true,
-			}
+				endDateLabel := fmt.Sprintf("End date (%s): (%s)", dateFormatDisplay, startDateInput)
 
-			endDateInput, err := endDatePrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
+				endDatePrompt := promptui.Prompt{
+					Label:     endDateLabel,
+					AllowEdit: true,
+				}
 
-			endDateInput = strings.TrimSpace(endDateInput)
-			if endDateInput == "" {
-				endDateInput = startDateInput
-			}
+				endDateInput, err := endDatePrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
 
-			if err := validateDate(endDateInput, dateFormatLayout, dateFormatDisplay); err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
+				endDateInput = strings.TrimSpace(endDateInput)
+				if endDateInput == "" {
+					endDateInput = startDateInput
+				}
 
-			endTimePrompt := promptui.Prompt{
-				Label:    "End time (e.g., 5:00 PM or 17:00)",
-				Validate: validateTime,
-			}
+				if err := validateDate(endDateInput, dateFormatLayout, dateFormatDisplay); err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
 
-			endTimeStr, err := endTimePrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
+				endTimePrompt := promptui.Prompt{
+					Label:    "End time (e.g., 5:00 PM or 17:00)",
+					Validate: validateTime,
+				}
 
-			if err := validateEndDateTime(startDateInput, startTimeStr, endDateInput, endTimeStr, dateFormatLayout); err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
+				endTimeStr, err := endTimePrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
 
-			descriptionPrompt := promptui.Prompt{
-				Label: "Description (optional, press Enter to skip)",
-			}
+				if err := validateEndDateTime(startDateInput, startTimeStr, endDateInput, endTimeStr, dateFormatLayout); err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
 
-			description, err := descriptionPrompt.Run()
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
-				os.Exit(1)
-			}
+				descriptionPrompt := promptui.Prompt{
+					Label: "Description (optional, press Enter to skip)",
+				}
 
-			startTime, err := parseDateTime(startDateInput, startTimeStr, dateFormatLayout)
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("parsing start time: %v", err))
-				os.Exit(1)
-			}
+				description, err = descriptionPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				startTime, err = parseDateTime(startDateInput, startTimeStr, dateFormatLayout)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("parsing start time: %v", err))
+					os.Exit(1)
+				}
+
+				endTime, err = parseDateTime(endDateInput, endTimeStr, dateFormatLayout)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("parsing end time: %v", err))
+					os.Exit(1)
+				}
 
-			endTime, err := parseDateTime(endDateInput, endTimeStr, dateFormatLayout)
-			if err != nil {
-				ui.PrintError(ui.EmojiError, fmt.Sprintf("parsing end time: %v", err))
-				os.Exit(1)
 			}
 
 			var hourlyRate *float64
@@ -178,9 +230,15 @@ func ManualCmd() *cobra.Command {
 			defer db.Close()
 
 			// Check for available milestones
-			var milestoneName *string
-			milestones, err := db.GetMilestonesByProject(projectName)
-			if err == nil && len(milestones) > 0 {
+			var milestoneID *int64
+			if manualMilestoneFlag != "" {
+				milestone, err := findMilestone(db, projectName, manualMilestoneFlag)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+				milestoneID = &milestone.ID
+			} else if milestones, err := db.GetMilestonesByProject(projectName); !nonInteractive && err == nil && len(milestones) > 0 {
 				// Build milestone options
 				milestoneOptions := []string{"(None)"}
 				for _, m := range milestones {
@@ -205,11 +263,19 @@ func ManualCmd() *cobra.Command {
 				// If not "(None)", assign the milestone
 				if milestoneIdx > 0 {
 					selectedMilestone := milestones[milestoneIdx-1]
-					milestoneName = &selectedMilestone.Name
+					milestoneID = &selectedMilestone.ID
 				}
 			}
 
-			entry, err := db.CreateManualEntry(projectName, description, startTime, endTime, hourlyRate, milestoneName)
+			// a rate scheduled for the entry's start takes precedence over the configured one
+			if scheduledRate, err := db.ResolveRate(projectName, milestoneID, nil, startTime); err == nil && scheduledRate != nil {
+				hourlyRate = scheduledRate
+			}
+
+			projectSettings := project.LoadProjectSettings(projectName)
+			billable := !manualNonBillable && projectSettings.IsBillable()
+
+			entry, err := db.CreateManualEntry(projectName, description, startTime, endTime, hourlyRate, milestoneID, billable)
 			if err != nil {
 				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
 				os.Exit(1)
@@ -218,6 +284,7 @@ func ManualCmd() *cobra.Command {
 			duration := entry.Duration()
 			fmt.Println()
 			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Created manual entry for %s", ui.Bold(entry.ProjectName)))
+			ui.PrintInfo(4, ui.Bold("ID"), fmt.Sprintf("%d", entry.ID))
 			ui.PrintInfo(4, ui.Bold("Start"), settings.FormatDateTimeLong(startTime))
 			ui.PrintInfo(4, ui.Bold("End"), settings.FormatDateTimeLong(endTime))
 			ui.PrintInfo(4, ui.Bold("Duration"), ui.FormatDuration(duration))
@@ -230,16 +297,15 @@ func ManualCmd() *cobra.Command {
 				ui.PrintInfo(4, ui.Bold("Milestone"), *entry.MilestoneName)
 			}
 
-			if entry.HourlyRate != nil {
-				// Get currency from global config
-				currencyCode := currency.DefaultCurrency
-				if globalCfg, err := settings.LoadGlobalConfig(); err == nil {
-					currencyCode = globalCfg.Currency
-				}
+			if !entry.Billable {
+				ui.PrintInfo(4, ui.Bold("Billable"), "no")
+			}
+
+			if entry.IsBilled() {
+				currencyCode := projectSettings.CurrencyCode()
 
-				earnings := entry.RoundedHours() * *entry.HourlyRate
-				fmt.Printf("    %s %s\n", ui.BoldInfo("Hourly Rate:"), currency.FormatCurrency(*entry.HourlyRate, currencyCode))
-				fmt.Printf("    %s %s\n", ui.BoldInfo("Earnings:"), currency.FormatCurrency(earnings, currencyCode))
+				fmt.Printf("    %s %s\n", ui.BoldInfo("Hourly Rate:"), settings.FormatCurrency(*entry.HourlyRate, currencyCode))
+				fmt.Printf("    %s %s\n", ui.BoldInfo("Earnings:"), settings.FormatCurrency(entry.Earnings(), currencyCode))
 			}
 
 			ui.NewlineBelow()
@@ -247,6 +313,11 @@ func ManualCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&manualProjectFlag, "project", "p", "", "Create entry for a specific global project")
+	cmd.Flags().StringVar(&manualStartFlag, "start", "", "Start date and time, e.g. \"2026-10-17 9:00\" (skips the prompts)")
+	cmd.Flags().StringVar(&manualEndFlag, "end", "", "End date and time, or just a time on the start date")
+	cmd.Flags().StringVarP(&manualDescription, "description", "d", "", "Description for the entry")
+	cmd.Flags().StringVarP(&manualMilestoneFlag, "milestone", "m", "", "Assign the entry to a milestone by name")
+	cmd.Flags().BoolVar(&manualNonBillable, "non-billable", false, "Don't count the entry towards earnings")
 
 	return cmd
 }
@@ -290,7 +361,6 @@ func validateTime(input string) error {
 	return fmt.Errorf("invalid time format, use 12-hour (e.g., 9:30 AM) or 24-hour (e.g., 14:30)")
 }
 
-
 func validateEndDateTime(startDate, startTime, endDate, endTime, dateLayout string) error {
 	start, err := parseDateTime(startDate, startTime, dateLayout)
 	if err != nil {
@@ -302,6 +372,11 @@ func validateEndDateTime(startDate, startTime, endDate, endTime, dateLayout stri
 		return fmt.Errorf("invalid end datetime: %w", err)
 	}
 
+	return validateEntryRange(start, end)
+}
+
+// validateEntryRange checks that an entry ends after it starts.
+func validateEntryRange(start, end time.Time) error {
 	if !end.After(start) {
 		return fmt.Errorf("end time must be after start time")
 	}
@@ -309,19 +384,72 @@ func validateEndDateTime(startDate, startTime, endDate, endTime, dateLayout stri
 	return nil
 }
 
+// parseDateTimeFlag parses a --start or --end value such as "2026-10-17 14:30"
+// or "10-17-2026 2:30 PM". The date can be ISO or the configured format. When
+// the value is only a time, the date is taken from fallback (an error if nil).
+// Dates and times go through the same validation as the interactive prompts.
+func parseDateTimeFlag(input, layout, displayFormat string, fallback *time.Time) (time.Time, error) {
+	input = strings.TrimSpace(input)
+
+	var date, timeStr string
+	if validateTime(input) == nil {
+		if fallback == nil {
+			return time.Time{}, fmt.Errorf("missing date, use \"%s HH:MM\"", displayFormat)
+		}
+		date = fallback.In(settings.GetDisplayTimezone()).Format(layout)
+		timeStr = input
+	} else {
+		var found bool
+		date, timeStr, found = strings.Cut(input, " ")
+		if !found {
+			return time.Time{}, fmt.Errorf("missing time, use \"%s HH:MM\"", displayFormat)
+		}
+		timeStr = strings.TrimSpace(timeStr)
+
+		// accept ISO dates regardless of the configured format
+		if isoDate, err := time.Parse("2006-01-02", date); err == nil {
+			date = isoDate.Format(layout)
+		}
+	}
+
+	if err := validateDate(date, layout, displayFormat); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := validateTime(timeStr); err != nil {
+		return time.Time{}, err
+	}
+
+	return parseDateTime(date, timeStr, layout)
+}
+
+// findMilestone looks up a milestone of projectName by name.
+func findMilestone(db *storage.Database, projectName, name string) (*storage.Milestone, error) {
+	milestone, err := db.GetMilestoneByName(projectName, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if milestone == nil {
+		return nil, fmt.Errorf("milestone '%s' not found for project '%s'", name, projectName)
+	}
+
+	return milestone, nil
+}
+
 func parseDateTime(date, timeStr, dateLayout string) (time.Time, error) {
 	normalizedTime := normalizeAMPM(timeStr)
 	dateTime := fmt.Sprintf("%s %s", date, normalizedTime)
 
-	if dt, err := time.ParseInLocation(dateLayout + " 3:04 PM", dateTime, settings.GetDisplayTimezone()); err == nil {
+	if dt, err := time.ParseInLocation(dateLayout+" 3:04 PM", dateTime, settings.GetDisplayTimezone()); err == nil {
 		return dt, nil
 	}
 
-	if dt, err := time.ParseInLocation(dateLayout + " 03:04 PM", dateTime, settings.GetDisplayTimezone()); err == nil {
+	if dt, err := time.ParseInLocation(dateLayout+" 03:04 PM", dateTime, settings.GetDisplayTimezone()); err == nil {
 		return dt, nil
 	}
 
-	return time.ParseInLocation(dateLayout + " 15:04", dateTime, settings.GetDisplayTimezone())
+	return time.ParseInLocation(dateLayout+" 15:04", dateTime, settings.GetDisplayTimezone())
 }
 
 func normalizeAMPM(input string) string {
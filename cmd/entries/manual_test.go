@@ -296,3 +296,50 @@ func TestNormalizeAMPM(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDateTimeFlag(t *testing.T) {
+	fallback, err := parseDateTime("12-25-2024", "9:00", "01-02-2006")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		input    string
+		fallback *time.Time
+		wantErr  bool
+		wantDay  int
+		wantHour int
+		wantMin  int
+	}{
+		{name: "configured format", input: "12-24-2024 14:30", wantDay: 24, wantHour: 14, wantMin: 30},
+		{name: "ISO date", input: "2024-12-24 9:15 am", wantDay: 24, wantHour: 9, wantMin: 15},
+		{name: "time only uses fallback date", input: "5:00 PM", fallback: &fallback, wantDay: 25, wantHour: 17},
+		{name: "time only without fallback", input: "17:00", wantErr: true},
+		{name: "date only", input: "2024-12-24", wantErr: true},
+		{name: "invalid time", input: "2024-12-24 25:00", wantErr: true},
+		{name: "future date", input: "2099-01-01 9:00", wantErr: true},
+		{name: "empty", input: "", fallback: &fallback, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseDateTimeFlag(tt.input, "01-02-2006", "MM-DD-YYYY", tt.fallback)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantDay, result.Day())
+			assert.Equal(t, tt.wantHour, result.Hour())
+			assert.Equal(t, tt.wantMin, result.Minute())
+		})
+	}
+}
+
+func TestValidateEntryRange(t *testing.T) {
+	start := time.Date(2024, 12, 25, 9, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, validateEntryRange(start, start.Add(time.Minute)))
+	assert.Error(t, validateEntryRange(start, start))
+	assert.Error(t, validateEntryRange(start, start.Add(-time.Hour)))
+}
@@ -0,0 +1,108 @@
+package entries
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var mergeYes bool
+
+func MergeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge <id> <id>",
+		Short: "Merge two adjacent entries",
+		Long: `Merge two consecutive entries of the same project into one.
+
+The merged entry runs from the start of the earlier entry to the end of the
+later one. Both entries must have the same hourly rate and milestone; their tags
+and commits are combined and differing descriptions are joined.
+
+  tmpo entries merge 41 42`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			earlier, err := getEntryArg(db, args[0])
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			later, err := getEntryArg(db, args[1])
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if later.StartTime.Before(earlier.StartTime) {
+				earlier, later = later, earlier
+			}
+
+			if earlier.ID == later.ID {
+				ui.PrintError(ui.EmojiError, "cannot merge an entry with itself")
+				os.Exit(1)
+			}
+
+			if err := storage.CheckMergeable(earlier, later); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess("🔗", fmt.Sprintf("Merge entries #%d and #%d", earlier.ID, later.ID))
+			fmt.Println()
+			ui.PrintInfo(4, ui.Bold(fmt.Sprintf("#%d", earlier.ID)), formatEntryLabelForDelete(earlier))
+			ui.PrintInfo(4, ui.Bold(fmt.Sprintf("#%d", later.ID)), formatEntryLabelForDelete(later))
+
+			if gap := later.StartTime.Sub(*earlier.EndTime); gap > 0 {
+				fmt.Println()
+				ui.PrintWarning(ui.EmojiWarning, fmt.Sprintf("The %s between the entries will be tracked as well", ui.FormatDuration(gap)))
+			}
+			fmt.Println()
+
+			if !mergeYes {
+				confirmPrompt := promptui.Select{
+					Label: "Merge these entries?",
+					Items: []string{"Yes", "No"},
+				}
+
+				_, result, err := confirmPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if result == "No" {
+					ui.PrintWarning(ui.EmojiWarning, "Merge cancelled")
+					ui.NewlineBelow()
+					os.Exit(0)
+				}
+			}
+
+			merged, err := db.MergeEntries(earlier.ID, later.ID)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Merged into entry #%d", merged.ID))
+			ui.PrintInfo(4, ui.Bold("Entry"), formatEntryLabelForDelete(merged))
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().BoolVarP(&mergeYes, "yes", "y", false, "Merge without asking for confirmation")
+
+	return cmd
+}
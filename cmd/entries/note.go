@@ -0,0 +1,167 @@
+package entries
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var noteSet string
+
+func NoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "note [id]",
+		Short: "Edit the notes of a time entry",
+		Long: `Write longer, multi-line notes about what was done during an entry.
+
+Opens the entry's notes in $VISUAL or $EDITOR. Without an ID, the running
+entry is used, or the most recent one if nothing is running. Notes are shown
+by 'tmpo log --verbose' and included in exports.
+
+Pass --set to replace the notes without opening an editor, e.g. from scripts:
+
+  tmpo note 42 --set "Reviewed the PR and left comments"`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			var entry *storage.TimeEntry
+			if len(args) > 0 {
+				entry, err = getEntryArg(db, args[0])
+			} else {
+				entry, err = getCurrentOrLastEntry(db)
+			}
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			notes := noteSet
+			if !cmd.Flags().Changed("set") {
+				notes, err = editInEditor(entry.Notes)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+			}
+			notes = strings.TrimSpace(notes)
+
+			if notes == entry.Notes {
+				ui.PrintMuted(0, fmt.Sprintf("Notes of entry #%d unchanged.", entry.ID))
+				ui.NewlineBelow()
+				return
+			}
+
+			if err := db.SetEntryNotes(entry.ID, notes); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if notes == "" {
+				ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Cleared the notes of entry #%d", entry.ID))
+			} else {
+				ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Saved the notes of entry #%d", entry.ID))
+			}
+			ui.PrintInfo(4, ui.Bold("Project"), entry.ProjectName)
+			if entry.Description != "" {
+				ui.PrintInfo(4, ui.Bold("Description"), entry.Description)
+			}
+
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().StringVar(&noteSet, "set", "", "Replace the notes with this text instead of opening an editor (empty clears them)")
+
+	return cmd
+}
+
+// getCurrentOrLastEntry returns the running entry, or the most recent one when
+// nothing is running.
+func getCurrentOrLastEntry(db *storage.Database) (*storage.TimeEntry, error) {
+	entry, err := db.GetRunningEntry()
+	if err != nil {
+		return nil, err
+	}
+	if entry != nil {
+		return entry, nil
+	}
+
+	entries, err := db.GetEntries(1)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no time entries found")
+	}
+
+	return entries[0], nil
+}
+
+// editInEditor opens text in the user's editor and returns what was saved.
+func editInEditor(text string) (string, error) {
+	file, err := os.CreateTemp("", "tmpo-note-*.md")
+	if err != nil {
+		return "", fmt.Errorf("failed to create notes file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.WriteString(text); err != nil {
+		file.Close()
+		return "", fmt.Errorf("failed to write notes file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to write notes file: %w", err)
+	}
+
+	editor := getEditor()
+
+	// the editor runs through the shell so values like "code --wait" work
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", editor+` "`+file.Name()+`"`)
+	} else {
+		cmd = exec.Command("sh", "-c", editor+` "$1"`, "sh", file.Name())
+	}
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("editor '%s' failed: %w", editor, err)
+	}
+
+	content, err := os.ReadFile(file.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read notes file: %w", err)
+	}
+
+	return string(content), nil
+}
+
+func getEditor() string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if editor := strings.TrimSpace(os.Getenv(name)); editor != "" {
+			return editor
+		}
+	}
+
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+
+	return "vi"
+}
@@ -0,0 +1,271 @@
+package entries
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/reconstruct"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	reconstructSince      string
+	reconstructUntil      string
+	reconstructGap        time.Duration
+	reconstructLeadIn     time.Duration
+	reconstructAuthor     string
+	reconstructAllAuthors bool
+	reconstructProject    string
+	reconstructYes        bool
+)
+
+func ReconstructCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconstruct",
+		Short: "Reconstruct time entries from git commits",
+		Long: `Propose time entries for untracked work based on the current repository's git history.
+
+Commits are grouped into sessions: a new session starts when two commits are further
+apart than --gap, and each session starts --lead-in before its first commit. Sessions
+that overlap existing entries are skipped. Accepted sessions are saved as manual
+entries with their commits linked.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			if reconstructSince == "" {
+				ui.PrintError(ui.EmojiError, "--since is required (e.g. --since 2026-10-01)")
+				os.Exit(1)
+			}
+
+			if reconstructGap <= 0 || reconstructLeadIn < 0 {
+				ui.PrintError(ui.EmojiError, "--gap must be positive and --lead-in cannot be negative")
+				os.Exit(1)
+			}
+
+			globalCfg, err := settings.LoadGlobalConfig()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("loading config: %v", err))
+				os.Exit(1)
+			}
+
+			_, dateFormatLayout := getDateFormatInfo(globalCfg.DateFormat)
+
+			since, err := settings.ParseDate(reconstructSince, dateFormatLayout)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid --since: %v", err))
+				os.Exit(1)
+			}
+
+			until := time.Now()
+			if reconstructUntil != "" {
+				untilDate, err := settings.ParseDate(reconstructUntil, dateFormatLayout)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid --until: %v", err))
+					os.Exit(1)
+				}
+				until = untilDate.AddDate(0, 0, 1)
+			}
+
+			if !until.After(since) {
+				ui.PrintError(ui.EmojiError, "--until must be after --since")
+				os.Exit(1)
+			}
+
+			if !project.IsInGitRepo() {
+				ui.PrintError(ui.EmojiError, "not in a git repository")
+				os.Exit(1)
+			}
+
+			projectName, err := project.DetectConfiguredProjectWithOverride(reconstructProject)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+				os.Exit(1)
+			}
+
+			author := reconstructAuthor
+			if author == "" && !reconstructAllAuthors {
+				author, err = project.GetGitUserEmail()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					ui.PrintMuted(0, "Use --author or --all-authors to choose whose commits to use.")
+					os.Exit(1)
+				}
+			}
+
+			commits, err := project.GetGitLog(since, until, author)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if len(commits) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, "No commits found in that range.")
+				ui.NewlineBelow()
+				return
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			// widen the lookup so entries that started before --since are still seen
+			existing, err := db.GetEntriesByDateRange(since.Add(-24*time.Hour-reconstructLeadIn), until)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			sessions := reconstruct.Cluster(commits, reconstructGap, reconstructLeadIn)
+			reconstruct.MarkOverlaps(sessions, existing)
+
+			ui.PrintSuccess(ui.EmojiManual, fmt.Sprintf("Proposed sessions for %s", ui.Bold(projectName)))
+			ui.PrintMuted(4, fmt.Sprintf("└─ %d commits, gap %s, lead-in %s", len(commits), ui.FormatDuration(reconstructGap), ui.FormatDuration(reconstructLeadIn)))
+			fmt.Println()
+
+			var candidates []reconstruct.Session
+			for i, session := range sessions {
+				label := fmt.Sprintf("%2d. %s", i+1, formatSessionLabel(session))
+				switch {
+				case session.Overlaps:
+					fmt.Printf("  %s %s\n", ui.Muted(label), ui.Warning("(overlaps an existing entry, skipped)"))
+				case session.Duration() <= 0:
+					fmt.Printf("  %s %s\n", ui.Muted(label), ui.Warning("(no duration, skipped)"))
+				default:
+					fmt.Printf("  %s\n", label)
+					candidates = append(candidates, session)
+				}
+			}
+			fmt.Println()
+
+			if len(candidates) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, "Nothing to reconstruct.")
+				ui.NewlineBelow()
+				return
+			}
+
+			accepted := candidates
+			if !reconstructYes {
+				accepted, err = chooseSessions(candidates)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+			}
+
+			if len(accepted) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, "No entries created")
+				ui.NewlineBelow()
+				return
+			}
+
+			total, err := createSessionEntries(db, projectName, accepted)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			fmt.Println()
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Created %d entries for %s", len(accepted), ui.Bold(projectName)))
+			ui.PrintInfo(4, ui.Bold("Total Time"), ui.FormatDuration(total))
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().StringVar(&reconstructSince, "since", "", "First day to reconstruct (YYYY-MM-DD or your configured date format)")
+	cmd.Flags().StringVar(&reconstructUntil, "until", "", "Last day to reconstruct (default: now)")
+	cmd.Flags().DurationVar(&reconstructGap, "gap", reconstruct.DefaultGap, "Longest pause between commits within one session")
+	cmd.Flags().DurationVar(&reconstructLeadIn, "lead-in", reconstruct.DefaultLeadIn, "Time counted before the first commit of each session")
+	cmd.Flags().StringVar(&reconstructAuthor, "author", "", "Only use commits by this author (default: git user.email)")
+	cmd.Flags().BoolVar(&reconstructAllAuthors, "all-authors", false, "Use commits from every author")
+	cmd.Flags().StringVarP(&reconstructProject, "project", "p", "", "Create entries for a specific global project")
+	cmd.Flags().BoolVarP(&reconstructYes, "yes", "y", false, "Create all proposed entries without prompting")
+
+	return cmd
+}
+
+// createSessionEntries saves accepted sessions as manual entries with their
+// commits linked, at the project's scheduled or configured rate and billable
+// according to its default. It returns the total time created.
+func createSessionEntries(db *storage.Database, projectName string, sessions []reconstruct.Session) (time.Duration, error) {
+	projectSettings := project.LoadProjectSettings(projectName)
+
+	var total time.Duration
+	for _, session := range sessions {
+		hourlyRate := projectSettings.HourlyRate
+		if scheduledRate, err := db.ResolveRate(projectName, nil, nil, session.Start); err == nil && scheduledRate != nil {
+			hourlyRate = scheduledRate
+		}
+
+		entry, err := db.CreateManualEntry(projectName, session.Description(), session.Start, session.End, hourlyRate, nil, projectSettings.IsBillable())
+		if err != nil {
+			return total, err
+		}
+
+		for _, commit := range session.Commits {
+			if err := db.AddEntryCommit(entry.ID, commit.SHA, commit.Subject, commit.Time); err != nil {
+				return total, err
+			}
+		}
+
+		total += session.Duration()
+	}
+
+	return total, nil
+}
+
+func formatSessionLabel(session reconstruct.Session) string {
+	return fmt.Sprintf("%s → %s (%s) - %s",
+		settings.FormatDateTimeDashed(session.Start),
+		settings.FormatTime(session.End),
+		ui.FormatDuration(session.Duration()),
+		session.Description())
+}
+
+func chooseSessions(candidates []reconstruct.Session) ([]reconstruct.Session, error) {
+	createAll := fmt.Sprintf("Create all %d entries", len(candidates))
+	modePrompt := promptui.Select{
+		Label: "Create these entries?",
+		Items: []string{createAll, "Choose entries one by one", "Cancel"},
+	}
+
+	idx, _, err := modePrompt.Run()
+	if err != nil {
+		return nil, err
+	}
+
+	switch idx {
+	case 0:
+		return candidates, nil
+	case 2:
+		return nil, nil
+	}
+
+	var accepted []reconstruct.Session
+	for _, session := range candidates {
+		confirmPrompt := promptui.Select{
+			Label: formatSessionLabel(session),
+			Items: []string{"Yes", "No"},
+		}
+
+		_, result, err := confirmPrompt.Run()
+		if err != nil {
+			return nil, err
+		}
+
+		if result == "Yes" {
+			accepted = append(accepted, session)
+		}
+	}
+
+	return accepted, nil
+}
@@ -0,0 +1,62 @@
+package entries
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/reconstruct"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSessionEntriesUsesProjectSettings(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("TMPO_DEV", "")
+
+	rate := 80.0
+	nonBillable := false
+	registry, err := settings.LoadProjects()
+	require.NoError(t, err)
+	require.NoError(t, registry.AddProject(settings.GlobalProject{Name: "internal", HourlyRate: &rate, Billable: &nonBillable}))
+	require.NoError(t, registry.Save())
+
+	db, err := storage.Initialize()
+	require.NoError(t, err)
+	defer db.Close()
+
+	start := time.Date(2024, 1, 15, 9, 0, 0, 0, time.Local)
+	sessions := []reconstruct.Session{
+		{Start: start, End: start.Add(time.Hour), Commits: []project.GitCommit{{SHA: "abc1234def", Subject: "Fix login", Time: start.Add(time.Hour)}}},
+		{Start: start.Add(3 * time.Hour), End: start.Add(4 * time.Hour)},
+	}
+
+	total, err := createSessionEntries(db, "internal", sessions)
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Hour, total)
+
+	entries, err := db.GetEntriesByProject("internal")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	for _, entry := range entries {
+		assert.False(t, entry.Billable)
+		require.NotNil(t, entry.HourlyRate)
+		assert.Equal(t, rate, *entry.HourlyRate)
+	}
+
+	// newest first
+	assert.Equal(t, "Fix login", entries[1].Description)
+	commits, err := db.GetEntryCommits(entries[1].ID)
+	require.NoError(t, err)
+	assert.Len(t, commits, 1)
+
+	_, err = createSessionEntries(db, "client", sessions[:1])
+	require.NoError(t, err)
+	entries, err = db.GetEntriesByProject("client")
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.True(t, entries[0].Billable)
+	assert.Nil(t, entries[0].HourlyRate)
+}
@@ -0,0 +1,122 @@
+package entries
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	splitAt          string
+	splitDescription string
+	splitYes         bool
+)
+
+func SplitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split <id>",
+		Short: "Split an entry in two",
+		Long: `Split an entry in two at the given time, e.g. when a session covered two tasks.
+
+The second part copies the rate, milestone, tags and issue key of the entry and
+takes the commits made after the split. Use --description to describe it;
+otherwise it keeps the original description.
+
+  tmpo entries split 42 --at 14:30 --description "Code review"`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			if splitAt == "" {
+				ui.PrintError(ui.EmojiError, "--at is required (e.g. --at 14:30)")
+				os.Exit(1)
+			}
+
+			globalCfg, err := settings.LoadGlobalConfig()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("loading config: %v", err))
+				os.Exit(1)
+			}
+
+			dateFormatDisplay, dateFormatLayout := getDateFormatInfo(globalCfg.DateFormat)
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			entry, err := getEntryArg(db, args[0])
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			// a bare time is on the day the entry started
+			at, err := parseDateTimeFlag(splitAt, dateFormatLayout, dateFormatDisplay, &entry.StartTime)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid --at: %v", err))
+				os.Exit(1)
+			}
+
+			first, second := *entry, *entry
+			first.EndTime = &at
+			second.StartTime = at
+			if splitDescription != "" {
+				second.Description = splitDescription
+			}
+
+			if !at.After(first.StartTime) || (entry.EndTime != nil && !at.Before(*entry.EndTime)) {
+				ui.PrintError(ui.EmojiError, "split time must be between the entry's start and end")
+				ui.PrintMuted(0, formatEntryLabelForDelete(entry))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess("✂️", fmt.Sprintf("Split entry #%d", entry.ID))
+			fmt.Println()
+			ui.PrintInfo(4, ui.Bold("First"), formatEntryLabelForDelete(&first))
+			ui.PrintInfo(4, ui.Bold("Second"), formatEntryLabelForDelete(&second))
+			fmt.Println()
+
+			if !splitYes {
+				confirmPrompt := promptui.Select{
+					Label: "Split this entry?",
+					Items: []string{"Yes", "No"},
+				}
+
+				_, result, err := confirmPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if result == "No" {
+					ui.PrintWarning(ui.EmojiWarning, "Split cancelled")
+					ui.NewlineBelow()
+					os.Exit(0)
+				}
+			}
+
+			_, created, err := db.SplitEntry(entry.ID, at, splitDescription)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Split entry #%d, the second part is #%d", entry.ID, created.ID))
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().StringVar(&splitAt, "at", "", "Time to split at, e.g. 14:30 or \"2026-10-17 14:30\"")
+	cmd.Flags().StringVarP(&splitDescription, "description", "d", "", "Description for the second part")
+	cmd.Flags().BoolVarP(&splitYes, "yes", "y", false, "Split without asking for confirmation")
+
+	return cmd
+}
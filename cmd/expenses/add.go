@@ -0,0 +1,127 @@
+package expenses
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	addProject  string
+	addDate     string
+	addCurrency string
+	addCategory string
+	addReceipt  string
+)
+
+func AddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <amount> [description]",
+		Short: "Add an expense",
+		Long: `Add an expense to a project. The amount is in the project's currency unless
+--currency is given, and the date defaults to today.
+
+Examples:
+  tmpo expense add 49.90 "Domain renewal" --category hosting
+  tmpo expense add 320 "Train to Berlin" --category travel --currency EUR --receipt ./ticket.pdf`,
+		Args: cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			amount, err := parseAmount(args[0])
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			var description string
+			if len(args) > 1 {
+				description = strings.TrimSpace(args[1])
+			}
+
+			projectName, err := project.DetectConfiguredProjectWithOverride(addProject)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+				os.Exit(1)
+			}
+
+			currencyCode := project.GetProjectCurrency(projectName)
+			if addCurrency != "" {
+				currencyCode, err = parseCurrencyCode(addCurrency)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+			}
+
+			now := time.Now().In(settings.GetDisplayTimezone())
+			date := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+			if addDate != "" {
+				date, err = settings.ParseDate(addDate, settings.DateLayoutDashed())
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid date '%s': %v", addDate, err))
+					os.Exit(1)
+				}
+			}
+
+			receiptPath, err := resolveReceipt(addReceipt)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			expense, err := db.AddExpense(&storage.Expense{
+				ProjectName: projectName,
+				Date:        date,
+				Amount:      amount,
+				Currency:    currencyCode,
+				Category:    addCategory,
+				Description: description,
+				ReceiptPath: receiptPath,
+			})
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiExpense, fmt.Sprintf("Added expense #%d of %s to %s", expense.ID, ui.Bold(settings.FormatCurrency(expense.Amount, expense.Currency)), ui.Bold(expense.ProjectName)))
+			ui.PrintInfo(4, ui.Bold("Date"), settings.FormatDateDashed(expense.Date))
+
+			if expense.Category != "" {
+				ui.PrintInfo(4, ui.Bold("Category"), expense.Category)
+			}
+
+			if expense.Description != "" {
+				ui.PrintInfo(4, ui.Bold("Description"), expense.Description)
+			}
+
+			if expense.ReceiptPath != "" {
+				ui.PrintInfo(4, ui.Bold("Receipt"), expense.ReceiptPath)
+			}
+
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().StringVarP(&addProject, "project", "p", "", "Add the expense to a specific project")
+	cmd.Flags().StringVar(&addDate, "date", "", "Date of the expense (default: today)")
+	cmd.Flags().StringVar(&addCurrency, "currency", "", "Currency of the amount (default: the project's currency)")
+	cmd.Flags().StringVarP(&addCategory, "category", "c", "", "Category, e.g. travel, licenses or hosting")
+	cmd.Flags().StringVar(&addReceipt, "receipt", "", "Path to the receipt file")
+
+	return cmd
+}
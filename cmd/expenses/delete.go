@@ -0,0 +1,80 @@
+package expenses
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var deleteYes bool
+
+func DeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete an expense",
+		Long:  `Delete an expense by the ID shown in 'tmpo expense list'. The receipt file is left in place.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid expense ID '%s'", args[0]))
+				os.Exit(1)
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			expense, err := db.GetExpense(id)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			if expense == nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("expense #%d not found", id))
+				os.Exit(1)
+			}
+
+			if !deleteYes {
+				confirmPrompt := promptui.Select{
+					Label: fmt.Sprintf("Delete expense %s?", formatExpense(expense)),
+					Items: []string{"No", "Yes"},
+				}
+
+				_, result, err := confirmPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if result == "No" {
+					ui.PrintWarning(ui.EmojiWarning, "Deletion cancelled")
+					ui.NewlineBelow()
+					os.Exit(0)
+				}
+			}
+
+			if _, err := db.DeleteExpense(id); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Deleted expense %s", formatExpense(expense)))
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().BoolVarP(&deleteYes, "yes", "y", false, "Delete without asking for confirmation")
+
+	return cmd
+}
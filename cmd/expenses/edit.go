@@ -0,0 +1,149 @@
+package expenses
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	editProject     string
+	editDate        string
+	editAmount      string
+	editCurrency    string
+	editCategory    string
+	editDescription string
+	editReceipt     string
+)
+
+func EditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit <id>",
+		Short: "Edit an expense",
+		Long: `Change the fields of an expense given as flags, by the ID shown in
+'tmpo expense list'. Pass an empty value to clear the category, description or
+receipt.
+
+Examples:
+  tmpo expense edit 12 --amount 54.90
+  tmpo expense edit 12 --category licenses --receipt ""`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid expense ID '%s'", args[0]))
+				os.Exit(1)
+			}
+
+			flags := cmd.Flags()
+			if !flags.Changed("project") && !flags.Changed("date") && !flags.Changed("amount") &&
+				!flags.Changed("currency") && !flags.Changed("category") &&
+				!flags.Changed("description") && !flags.Changed("receipt") {
+				ui.PrintError(ui.EmojiError, "nothing to change, use flags like --amount or --category")
+				os.Exit(1)
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			expense, err := db.GetExpense(id)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			if expense == nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("expense #%d not found", id))
+				os.Exit(1)
+			}
+
+			if flags.Changed("project") {
+				expense.ProjectName = strings.TrimSpace(editProject)
+			}
+
+			if flags.Changed("date") {
+				expense.Date, err = settings.ParseDate(editDate, settings.DateLayoutDashed())
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid date '%s': %v", editDate, err))
+					os.Exit(1)
+				}
+			}
+
+			if flags.Changed("amount") {
+				expense.Amount, err = parseAmount(editAmount)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+			}
+
+			if flags.Changed("currency") {
+				expense.Currency, err = parseCurrencyCode(editCurrency)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+			}
+
+			if flags.Changed("category") {
+				expense.Category = editCategory
+			}
+
+			if flags.Changed("description") {
+				expense.Description = editDescription
+			}
+
+			if flags.Changed("receipt") {
+				expense.ReceiptPath, err = resolveReceipt(editReceipt)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+			}
+
+			if err := db.UpdateExpense(id, expense); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			updated, err := db.GetExpense(id)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Updated expense %s", formatExpense(updated)))
+
+			if updated.Category != "" {
+				ui.PrintInfo(4, ui.Bold("Category"), updated.Category)
+			}
+
+			if updated.ReceiptPath != "" {
+				ui.PrintInfo(4, ui.Bold("Receipt"), updated.ReceiptPath)
+			}
+
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().StringVarP(&editProject, "project", "p", "", "Move the expense to another project")
+	cmd.Flags().StringVar(&editDate, "date", "", "New date of the expense")
+	cmd.Flags().StringVar(&editAmount, "amount", "", "New amount")
+	cmd.Flags().StringVar(&editCurrency, "currency", "", "New currency code")
+	cmd.Flags().StringVarP(&editCategory, "category", "c", "", "New category")
+	cmd.Flags().StringVarP(&editDescription, "description", "d", "", "New description")
+	cmd.Flags().StringVar(&editReceipt, "receipt", "", "New path to the receipt file")
+
+	return cmd
+}
@@ -0,0 +1,103 @@
+package expenses
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/DylanDevelops/tmpo/internal/currency"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/spf13/cobra"
+)
+
+func ExpenseCmds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expense",
+		Short: "Manage project expenses",
+		Long: `Track expenses such as travel, licenses or hosting next to a project's time.
+
+Expenses are included in 'tmpo stats' and written alongside exported entries.`,
+	}
+
+	cmd.AddCommand(AddCmd())
+	cmd.AddCommand(ListCmd())
+	cmd.AddCommand(EditCmd())
+	cmd.AddCommand(DeleteCmd())
+
+	return cmd
+}
+
+func parseAmount(input string) (float64, error) {
+	amount, err := strconv.ParseFloat(strings.TrimSpace(input), 64)
+	if err != nil || amount <= 0 {
+		return 0, fmt.Errorf("invalid amount '%s', use a number greater than 0 like 49.90", input)
+	}
+
+	return amount, nil
+}
+
+func parseCurrencyCode(input string) (string, error) {
+	code := strings.ToUpper(strings.TrimSpace(input))
+	if !currency.IsSupported(code) {
+		return "", fmt.Errorf("unknown ISO 4217 currency code '%s'", input)
+	}
+
+	return code, nil
+}
+
+// resolveReceipt returns the absolute path of a receipt file so it can be
+// found from any directory.
+func resolveReceipt(path string) (string, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return "", nil
+	}
+
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(home, path[1:])
+	}
+
+	absolute, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := os.Stat(absolute); err != nil {
+		return "", fmt.Errorf("receipt not found: %s", path)
+	}
+
+	return absolute, nil
+}
+
+// padLeft right-aligns a value in a column, counting runes rather than bytes
+// so currency symbols like € line up.
+func padLeft(value string, width int) string {
+	if padding := width - utf8.RuneCountInString(value); padding > 0 {
+		return strings.Repeat(" ", padding) + value
+	}
+
+	return value
+}
+
+// formatExpense summarizes an expense for prompts and messages.
+func formatExpense(expense *storage.Expense) string {
+	summary := fmt.Sprintf("#%d %s for %s on %s",
+		expense.ID,
+		settings.FormatCurrency(expense.Amount, expense.Currency),
+		expense.ProjectName,
+		settings.FormatDateDashed(expense.Date))
+
+	if expense.Description != "" {
+		summary += fmt.Sprintf(" (%s)", expense.Description)
+	}
+
+	return summary
+}
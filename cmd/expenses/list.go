@@ -0,0 +1,141 @@
+package expenses
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	listProject  string
+	listAll      bool
+	listRange    string
+	listCategory string
+)
+
+func ListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List expenses",
+		Long: `List the current project's expenses, latest first, with their total per currency.
+
+Examples:
+  tmpo expense list --range month
+  tmpo expense list --all --category travel`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			var filter storage.ExpenseFilter
+			var err error
+
+			if !listAll {
+				filter.Project, err = project.DetectConfiguredProjectWithOverride(listProject)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+					os.Exit(1)
+				}
+			}
+
+			if listRange != "" {
+				filter.Start, filter.End, err = settings.ParseDateRange(listRange, settings.DateLayoutDashed(), time.Now())
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid range: %v", err))
+					os.Exit(1)
+				}
+			}
+			filter.Category = listCategory
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			expenses, err := db.FindExpenses(filter)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if len(expenses) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, "No expenses found")
+				ui.PrintMuted(4, "Add one with 'tmpo expense add 49.90 \"Domain renewal\"'.")
+				ui.NewlineBelow()
+				return
+			}
+
+			title := fmt.Sprintf("Expenses (%d)", len(expenses))
+			if filter.Project != "" {
+				title = fmt.Sprintf("Expenses for %s (%d)", filter.Project, len(expenses))
+			}
+			ui.PrintSuccess(ui.EmojiExpense, title)
+			fmt.Println()
+
+			header := fmt.Sprintf("%-6s %-12s %-14s %14s  %s", "ID", "Date", "Category", "Amount", "Description")
+			if listAll {
+				header = fmt.Sprintf("%-6s %-12s %-20s %-14s %14s  %s", "ID", "Date", "Project", "Category", "Amount", "Description")
+			}
+			fmt.Printf("  %s\n", ui.Muted(header))
+
+			totals := make(map[string]float64)
+			for _, expense := range expenses {
+				totals[expense.Currency] += expense.Amount
+
+				description := expense.Description
+				if expense.ReceiptPath != "" {
+					description += " " + ui.Muted("[receipt]")
+				}
+
+				if listAll {
+					fmt.Printf("  %-6s %-12s %-20s %-14s %s  %s\n",
+						fmt.Sprintf("#%d", expense.ID),
+						settings.FormatDateDashed(expense.Date),
+						expense.ProjectName,
+						expense.Category,
+						padLeft(settings.FormatCurrency(expense.Amount, expense.Currency), 14),
+						description)
+				} else {
+					fmt.Printf("  %-6s %-12s %-14s %s  %s\n",
+						fmt.Sprintf("#%d", expense.ID),
+						settings.FormatDateDashed(expense.Date),
+						expense.Category,
+						padLeft(settings.FormatCurrency(expense.Amount, expense.Currency), 14),
+						description)
+				}
+			}
+
+			codes := make([]string, 0, len(totals))
+			for code := range totals {
+				codes = append(codes, code)
+			}
+			sort.Strings(codes)
+
+			amounts := make([]string, len(codes))
+			for i, code := range codes {
+				amounts[i] = settings.FormatCurrency(totals[code], code)
+			}
+
+			fmt.Println()
+			ui.PrintInfo(4, ui.Bold("Total"), strings.Join(amounts, " + "))
+
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().StringVarP(&listProject, "project", "p", "", "List the expenses of a specific project")
+	cmd.Flags().BoolVarP(&listAll, "all", "a", false, "List the expenses of every project")
+	cmd.Flags().StringVarP(&listRange, "range", "r", "", "Expenses in this range (today, week, last-week, month, last-month, DATE or FROM..TO)")
+	cmd.Flags().StringVarP(&listCategory, "category", "c", "", "Only list expenses of a category")
+	cmd.MarkFlagsMutuallyExclusive("project", "all")
+
+	return cmd
+}
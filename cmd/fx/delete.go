@@ -0,0 +1,51 @@
+package fx
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func DeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete an exchange rate",
+		Long:  `Delete an exchange rate by the ID shown in 'tmpo fx list'.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid exchange rate ID '%s'", args[0]))
+				os.Exit(1)
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			deleted, err := db.DeleteFXRate(id)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			if !deleted {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("exchange rate #%d not found", id))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Deleted exchange rate #%d", id))
+			ui.NewlineBelow()
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+package fx
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/DylanDevelops/tmpo/internal/currency"
+	"github.com/spf13/cobra"
+)
+
+func FXCmds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fx",
+		Short: "Manage exchange rates",
+		Long: `Manage the exchange rates used to convert earnings between currencies.
+
+Projects can be billed in their own currency (the currency field of a global
+project or .tmporc). 'tmpo stats' shows earnings per currency and converted to
+your reporting currency, using the rate in effect on the day of each entry.`,
+	}
+
+	cmd.AddCommand(SetCmd())
+	cmd.AddCommand(ListCmd())
+	cmd.AddCommand(DeleteCmd())
+	cmd.AddCommand(ImportCmd())
+
+	return cmd
+}
+
+// parseCurrencyCode validates and normalizes an ISO 4217 currency code.
+func parseCurrencyCode(input string) (string, error) {
+	code := strings.ToUpper(strings.TrimSpace(input))
+
+	if len(code) != 3 {
+		return "", fmt.Errorf("invalid currency code '%s', use 3 letters (e.g., USD, EUR, GBP)", input)
+	}
+
+	for _, char := range code {
+		if char < 'A' || char > 'Z' {
+			return "", fmt.Errorf("invalid currency code '%s', use 3 letters (e.g., USD, EUR, GBP)", input)
+		}
+	}
+
+	if !currency.IsSupported(code) {
+		return "", fmt.Errorf("unknown ISO 4217 currency code '%s'", code)
+	}
+
+	return code, nil
+}
@@ -0,0 +1,125 @@
+package fx
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func ImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <file.csv>",
+		Short: "Import exchange rates from a CSV file",
+		Long: `Import exchange rates from a CSV file with the columns date, base, quote and
+rate, e.g. exported from your bank or a rates provider. A header row is
+skipped. Rates already stored for the same pair and date are replaced, and
+nothing is imported if any row is invalid.
+
+  date,base,quote,rate
+  2026-10-01,EUR,USD,1.08
+  2026-10-02,EUR,USD,1.09`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer file.Close()
+
+			rates, err := parseFXRates(file, settings.DateLayoutDashed())
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%s: %v", args[0], err))
+				os.Exit(1)
+			}
+
+			if len(rates) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, "No exchange rates found in the file.")
+				ui.NewlineBelow()
+				return
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			if err := db.SetFXRates(rates); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				ui.PrintMuted(0, "No exchange rates were imported.")
+				os.Exit(1)
+			}
+
+			pairs := make(map[string]bool)
+			for _, rate := range rates {
+				pairs[rate.Base+"/"+rate.Quote] = true
+			}
+
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Imported %d exchange rates", len(rates)))
+			ui.PrintInfo(4, ui.Bold("Currency Pairs"), fmt.Sprintf("%d", len(pairs)))
+			ui.NewlineBelow()
+		},
+	}
+
+	return cmd
+}
+
+// parseFXRates reads date,base,quote,rate rows. A first row whose rate isn't a
+// number is treated as a header.
+func parseFXRates(r io.Reader, dateLayout string) ([]*storage.FXRate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var rates []*storage.FXRate
+	for i, record := range records {
+		line := i + 1
+
+		value, err := strconv.ParseFloat(strings.TrimSpace(record[3]), 64)
+		if err != nil {
+			if i == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: invalid rate '%s'", line, record[3])
+		}
+		if value <= 0 {
+			return nil, fmt.Errorf("line %d: rate must be greater than 0", line)
+		}
+
+		date, err := settings.ParseDate(strings.TrimSpace(record[0]), dateLayout)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid date '%s': %v", line, record[0], err)
+		}
+
+		base, err := parseCurrencyCode(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+
+		quote, err := parseCurrencyCode(record[2])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+
+		rates = append(rates, &storage.FXRate{Base: base, Quote: quote, Rate: value, Date: date})
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,53 @@
+package fx
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFXRates(t *testing.T) {
+	t.Run("skips the header", func(t *testing.T) {
+		input := "date,base,quote,rate\n2026-10-01,eur,USD,1.08\n2026-10-02, EUR, USD, 1.09\n"
+
+		rates, err := parseFXRates(strings.NewReader(input), "01-02-2006")
+		require.NoError(t, err)
+		require.Len(t, rates, 2)
+
+		assert.Equal(t, "EUR", rates[0].Base)
+		assert.Equal(t, "USD", rates[0].Quote)
+		assert.Equal(t, 1.08, rates[0].Rate)
+		assert.Equal(t, 2026, rates[0].Date.Year())
+		assert.Equal(t, time.October, rates[0].Date.Month())
+		assert.Equal(t, 1, rates[0].Date.Day())
+		assert.Equal(t, 1.09, rates[1].Rate)
+	})
+
+	t.Run("accepts the configured date layout", func(t *testing.T) {
+		rates, err := parseFXRates(strings.NewReader("25-12-2026,GBP,EUR,1.17\n"), "02-01-2006")
+		require.NoError(t, err)
+		require.Len(t, rates, 1)
+		assert.Equal(t, 25, rates[0].Date.Day())
+	})
+
+	errorCases := []struct {
+		name  string
+		input string
+	}{
+		{"invalid rate", "2026-10-01,EUR,USD,1.08\n2026-10-02,EUR,USD,abc\n"},
+		{"zero rate", "2026-10-01,EUR,USD,0\n"},
+		{"invalid date", "10/01/2026,EUR,USD,1.08\n"},
+		{"invalid currency", "2026-10-01,EURO,USD,1.08\n"},
+		{"missing column", "2026-10-01,EUR,1.08\n"},
+	}
+
+	for _, tt := range errorCases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseFXRates(strings.NewReader(tt.input), "01-02-2006")
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package fx
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func ListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [base] [quote]",
+		Short: "List exchange rates",
+		Long:  `List the stored exchange rates, latest first, optionally only those of a base currency or currency pair.`,
+		Args:  cobra.MaximumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			var base, quote string
+			var err error
+			if len(args) > 0 {
+				base, err = parseCurrencyCode(args[0])
+			}
+			if err == nil && len(args) > 1 {
+				quote, err = parseCurrencyCode(args[1])
+			}
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			rates, err := db.GetFXRates(base, quote)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if len(rates) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, "No exchange rates found")
+				ui.PrintMuted(4, "Add one with 'tmpo fx set EUR USD 1.08'.")
+				ui.NewlineBelow()
+				return
+			}
+
+			ui.PrintSuccess(ui.EmojiRate, fmt.Sprintf("Exchange Rates (%d)", len(rates)))
+			fmt.Println()
+
+			fmt.Printf("  %s\n", ui.Muted(fmt.Sprintf("%-6s %-9s %-12s %s", "ID", "Pair", "Date", "Rate")))
+			for _, rate := range rates {
+				fmt.Printf("  %-6s %-9s %-12s %s\n",
+					fmt.Sprintf("#%d", rate.ID),
+					rate.Base+"/"+rate.Quote,
+					settings.FormatDateDashed(rate.Date),
+					strconv.FormatFloat(rate.Rate, 'f', -1, 64))
+			}
+
+			ui.NewlineBelow()
+		},
+	}
+
+	return cmd
+}
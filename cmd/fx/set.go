@@ -0,0 +1,80 @@
+package fx
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var setDate string
+
+func SetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <base> <quote> <rate>",
+		Short: "Set an exchange rate",
+		Long: `Set the exchange rate between two currencies, where one unit of base is worth
+rate units of quote. The rate applies from --date (default: today) until the
+next rate set for the pair, and is used inverted to convert the other way.
+
+  tmpo fx set EUR USD 1.08 --date 2026-10-01`,
+		Args: cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			base, err := parseCurrencyCode(args[0])
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			quote, err := parseCurrencyCode(args[1])
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			rate, err := strconv.ParseFloat(strings.TrimSpace(args[2]), 64)
+			if err != nil || rate <= 0 {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid rate '%s', use a number greater than 0", args[2]))
+				os.Exit(1)
+			}
+
+			now := time.Now().In(settings.GetDisplayTimezone())
+			date := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+			if setDate != "" {
+				date, err = settings.ParseDate(setDate, settings.DateLayoutDashed())
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid date '%s': %v", setDate, err))
+					os.Exit(1)
+				}
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			if err := db.SetFXRate(base, quote, rate, date); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Set 1 %s = %s %s", base, ui.Bold(strconv.FormatFloat(rate, 'f', -1, 64)), quote))
+			ui.PrintInfo(4, ui.Bold("Effective From"), settings.FormatDateDashed(date))
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().StringVar(&setDate, "date", "", "Date the rate applies from (default: today)")
+
+	return cmd
+}
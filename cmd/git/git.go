@@ -0,0 +1,17 @@
+package git
+
+import "github.com/spf13/cobra"
+
+func GitCmds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "git",
+		Short: "Integrate tracking with git",
+		Long:  `Install git hooks that start or switch tracking on checkout and link commits to the running time entry.`,
+	}
+
+	cmd.AddCommand(InstallHooksCmd())
+	cmd.AddCommand(UninstallHooksCmd())
+	cmd.AddCommand(HookCmd())
+
+	return cmd
+}
@@ -0,0 +1,142 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// HookCmd is invoked by the installed hook scripts; it is hidden from help.
+func HookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "hook [name] [args...]",
+		Short:  "Run a tmpo git hook",
+		Hidden: true,
+		Args:   cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("tmpo: %v", err))
+				os.Exit(1)
+			}
+
+			defer db.Close()
+
+			switch args[0] {
+			case "post-checkout":
+				// git passes <previous HEAD> <new HEAD> <branch checkout flag>;
+				// file checkouts (flag 0) don't change what is being worked on
+				if len(args) < 4 || args[3] != "1" {
+					return
+				}
+				err = runPostCheckout(db)
+			case "post-commit":
+				err = runPostCommit(db)
+			default:
+				err = fmt.Errorf("unknown hook '%s'", args[0])
+			}
+
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("tmpo: %v", err))
+				os.Exit(1)
+			}
+		},
+	}
+
+	return cmd
+}
+
+// runPostCheckout starts tracking the repository's project, stopping the
+// running entry first if it belongs to a different project.
+func runPostCheckout(db *storage.Database) error {
+	projectName, err := project.DetectConfiguredProject()
+	if err != nil {
+		return fmt.Errorf("detecting project: %w", err)
+	}
+
+	running, err := db.GetRunningEntry()
+	if err != nil {
+		return err
+	}
+
+	if running != nil {
+		if running.ProjectName == projectName {
+			return nil
+		}
+
+		if err := db.StopEntry(running.ID); err != nil {
+			return err
+		}
+		ui.PrintSuccess(ui.EmojiStop, fmt.Sprintf("tmpo: stopped tracking %s", ui.Bold(running.ProjectName)))
+	}
+
+	projectSettings := project.LoadProjectSettings(projectName)
+	hourlyRate := projectSettings.HourlyRate
+
+	var milestoneID *int64
+	if activeMilestone, _ := db.GetActiveMilestoneForProject(projectName); activeMilestone != nil {
+		milestoneID = &activeMilestone.ID
+	}
+
+	description := ""
+	issueKey := ""
+	if branchInfo, err := project.DeriveFromBranch(); err == nil && branchInfo != nil {
+		description = branchInfo.Description
+		issueKey = branchInfo.IssueKey
+
+		if branchInfo.Milestone != "" {
+			if branchMilestone, _ := db.GetMilestoneByName(projectName, branchInfo.Milestone); branchMilestone != nil && branchMilestone.IsActive() {
+				milestoneID = &branchMilestone.ID
+			}
+		}
+	}
+
+	if scheduledRate, err := db.ResolveRate(projectName, milestoneID, nil, time.Now()); err == nil && scheduledRate != nil {
+		hourlyRate = scheduledRate
+	}
+
+	entry, err := db.CreateEntry(projectName, description, hourlyRate, milestoneID, projectSettings.IsBillable())
+	if err != nil {
+		return err
+	}
+
+	if issueKey != "" {
+		if err := db.SetEntryIssueKey(entry.ID, issueKey); err != nil {
+			return err
+		}
+	}
+
+	ui.PrintSuccess(ui.EmojiStart, fmt.Sprintf("tmpo: started tracking %s", ui.Bold(entry.ProjectName)))
+
+	return nil
+}
+
+// runPostCommit links the new HEAD commit to the running entry, if any.
+func runPostCommit(db *storage.Database) error {
+	running, err := db.GetRunningEntry()
+	if err != nil {
+		return err
+	}
+
+	if running == nil {
+		return nil
+	}
+
+	commit, err := project.GetHeadCommit()
+	if err != nil {
+		return err
+	}
+
+	if err := db.AddEntryCommit(running.ID, commit.SHA, commit.Subject, commit.Time); err != nil {
+		return err
+	}
+
+	ui.PrintMuted(0, fmt.Sprintf("tmpo: linked %s to %s", commit.ShortSHA(), running.ProjectName))
+
+	return nil
+}
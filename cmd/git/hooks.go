@@ -0,0 +1,107 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// hookMarker identifies hook scripts written by tmpo so they can be updated or
+// removed without touching hooks installed by other tools.
+const hookMarker = "# Installed by tmpo"
+
+// backupSuffix is appended to existing hooks that --force replaces. The tmpo
+// hook runs the backup first so the replaced hook keeps working.
+const backupSuffix = ".tmpo-backup"
+
+var managedHooks = []string{"post-checkout", "post-commit"}
+
+type installResult int
+
+const (
+	hookInstalled installResult = iota
+	hookUpdated
+	hookReplaced
+	hookSkipped
+)
+
+func hookScript(name string) string {
+	return fmt.Sprintf(`#!/bin/sh
+%s (tmpo git install-hooks). Remove with: tmpo git uninstall-hooks
+status=0
+if [ -x "$0%s" ]; then
+	"$0%s" "$@"
+	status=$?
+fi
+if command -v tmpo >/dev/null 2>&1; then
+	tmpo git hook %s "$@" || true
+fi
+exit $status
+`, hookMarker, backupSuffix, backupSuffix, name)
+}
+
+func isTmpoHook(path string) bool {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+
+	return strings.Contains(string(content), hookMarker)
+}
+
+// installHook writes the tmpo hook script. An existing hook from another tool
+// is left alone unless force is set, in which case it is kept as a backup.
+// Force refuses to replace a hook when an earlier backup would be lost.
+func installHook(hooksDir, name string, force bool) (installResult, error) {
+	if err := os.MkdirAll(hooksDir, 0755); err != nil {
+		return hookSkipped, fmt.Errorf("failed to create hooks directory: %w", err)
+	}
+
+	path := filepath.Join(hooksDir, name)
+	result := hookInstalled
+
+	if _, err := os.Stat(path); err == nil {
+		switch {
+		case isTmpoHook(path):
+			result = hookUpdated
+		case !force:
+			return hookSkipped, nil
+		default:
+			if _, err := os.Stat(path + backupSuffix); err == nil {
+				return hookSkipped, fmt.Errorf("cannot replace the %s hook: %s%s already holds an earlier backup, move or merge it first", name, name, backupSuffix)
+			}
+			if err := os.Rename(path, path+backupSuffix); err != nil {
+				return hookSkipped, fmt.Errorf("failed to back up existing %s hook: %w", name, err)
+			}
+			result = hookReplaced
+		}
+	}
+
+	if err := os.WriteFile(path, []byte(hookScript(name)), 0755); err != nil {
+		return hookSkipped, fmt.Errorf("failed to write %s hook: %w", name, err)
+	}
+
+	return result, nil
+}
+
+// uninstallHook removes a tmpo hook and restores any hook it replaced.
+// It reports whether anything was removed.
+func uninstallHook(hooksDir, name string) (bool, error) {
+	path := filepath.Join(hooksDir, name)
+	if !isTmpoHook(path) {
+		return false, nil
+	}
+
+	if err := os.Remove(path); err != nil {
+		return false, fmt.Errorf("failed to remove %s hook: %w", name, err)
+	}
+
+	if _, err := os.Stat(path + backupSuffix); err == nil {
+		if err := os.Rename(path+backupSuffix, path); err != nil {
+			return true, fmt.Errorf("failed to restore previous %s hook: %w", name, err)
+		}
+	}
+
+	return true, nil
+}
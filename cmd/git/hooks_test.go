@@ -0,0 +1,135 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstallHook(t *testing.T) {
+	t.Run("installs into an empty hooks directory", func(t *testing.T) {
+		hooksDir := filepath.Join(t.TempDir(), "hooks")
+
+		result, err := installHook(hooksDir, "post-commit", false)
+		require.NoError(t, err)
+		assert.Equal(t, hookInstalled, result)
+
+		path := filepath.Join(hooksDir, "post-commit")
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.NotZero(t, info.Mode()&0100, "hook should be executable")
+		assert.True(t, isTmpoHook(path))
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `tmpo git hook post-commit "$@"`)
+	})
+
+	t.Run("updates an existing tmpo hook", func(t *testing.T) {
+		hooksDir := t.TempDir()
+
+		_, err := installHook(hooksDir, "post-checkout", false)
+		require.NoError(t, err)
+
+		result, err := installHook(hooksDir, "post-checkout", false)
+		require.NoError(t, err)
+		assert.Equal(t, hookUpdated, result)
+	})
+
+	t.Run("leaves foreign hooks alone without force", func(t *testing.T) {
+		hooksDir := t.TempDir()
+		path := filepath.Join(hooksDir, "post-commit")
+		require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\necho other\n"), 0755))
+
+		result, err := installHook(hooksDir, "post-commit", false)
+		require.NoError(t, err)
+		assert.Equal(t, hookSkipped, result)
+		assert.False(t, isTmpoHook(path))
+	})
+
+	t.Run("replaces foreign hooks with force and restores them on uninstall", func(t *testing.T) {
+		hooksDir := t.TempDir()
+		path := filepath.Join(hooksDir, "post-commit")
+		original := "#!/bin/sh\necho other\n"
+		require.NoError(t, os.WriteFile(path, []byte(original), 0755))
+
+		result, err := installHook(hooksDir, "post-commit", true)
+		require.NoError(t, err)
+		assert.Equal(t, hookReplaced, result)
+		assert.True(t, isTmpoHook(path))
+
+		removed, err := uninstallHook(hooksDir, "post-commit")
+		require.NoError(t, err)
+		assert.True(t, removed)
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, original, string(content))
+		_, err = os.Stat(path + backupSuffix)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestInstalledHookRunsReplacedHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hooks are shell scripts")
+	}
+	// keep a tmpo on PATH away from the real database
+	t.Setenv("HOME", t.TempDir())
+
+	hooksDir := t.TempDir()
+	marker := filepath.Join(t.TempDir(), "ran")
+	path := filepath.Join(hooksDir, "post-commit")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\necho \"$1\" > \""+marker+"\"\n"), 0755))
+
+	_, err := installHook(hooksDir, "post-commit", true)
+	require.NoError(t, err)
+
+	require.NoError(t, exec.Command(path, "arg").Run())
+
+	content, err := os.ReadFile(marker)
+	require.NoError(t, err)
+	assert.Equal(t, "arg\n", string(content))
+}
+
+func TestInstallHookKeepsEarlierBackup(t *testing.T) {
+	hooksDir := t.TempDir()
+	path := filepath.Join(hooksDir, "post-checkout")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\necho first\n"), 0755))
+
+	_, err := installHook(hooksDir, "post-checkout", true)
+	require.NoError(t, err)
+
+	// another tool reinstalls its hook over tmpo's
+	second := "#!/bin/sh\necho second\n"
+	require.NoError(t, os.WriteFile(path, []byte(second), 0755))
+
+	_, err = installHook(hooksDir, "post-checkout", true)
+	assert.Error(t, err)
+
+	backup, err := os.ReadFile(path + backupSuffix)
+	require.NoError(t, err)
+	assert.Equal(t, "#!/bin/sh\necho first\n", string(backup))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, second, string(content))
+}
+
+func TestUninstallHookIgnoresForeignHooks(t *testing.T) {
+	hooksDir := t.TempDir()
+	path := filepath.Join(hooksDir, "post-checkout")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"), 0755))
+
+	removed, err := uninstallHook(hooksDir, "post-checkout")
+	require.NoError(t, err)
+	assert.False(t, removed)
+
+	_, err = os.Stat(path)
+	assert.NoError(t, err)
+}
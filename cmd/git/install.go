@@ -0,0 +1,111 @@
+package git
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	installForce bool
+)
+
+func InstallHooksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "install-hooks",
+		Short: "Install tmpo git hooks in the current repository",
+		Long: `Install post-checkout and post-commit hooks in the current repository.
+
+After a branch checkout, tracking starts for the detected project (or switches to it
+if another project is being tracked). After each commit, the commit hash and message
+are recorded against the running time entry and shown in 'tmpo log' and exports.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			hooksDir, err := project.GetGitHooksDir()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			var skipped []string
+			for _, name := range managedHooks {
+				result, err := installHook(hooksDir, name, installForce)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				switch result {
+				case hookInstalled:
+					ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Installed %s hook", ui.Bold(name)))
+				case hookUpdated:
+					ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Updated %s hook", ui.Bold(name)))
+				case hookReplaced:
+					ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Installed %s hook", ui.Bold(name)))
+					ui.PrintMuted(4, fmt.Sprintf("└─ Previous hook saved as %s%s and still runs first", name, backupSuffix))
+				case hookSkipped:
+					skipped = append(skipped, name)
+					ui.PrintWarning(ui.EmojiWarning, fmt.Sprintf("Skipped %s: a hook from another tool already exists", ui.Bold(name)))
+				}
+			}
+
+			ui.PrintInfo(4, ui.Bold("Hooks Directory"), hooksDir)
+
+			if len(skipped) > 0 {
+				fmt.Println()
+				ui.PrintMuted(0, "Use 'tmpo git install-hooks --force' to replace existing hooks (they are kept as backups and still run).")
+			}
+
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().BoolVarP(&installForce, "force", "f", false, "Replace existing hooks, keeping a backup that still runs")
+
+	return cmd
+}
+
+func UninstallHooksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uninstall-hooks",
+		Short: "Remove tmpo git hooks from the current repository",
+		Long:  `Remove the hooks installed by 'tmpo git install-hooks', restoring any hooks they replaced.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			hooksDir, err := project.GetGitHooksDir()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			removed := 0
+			for _, name := range managedHooks {
+				ok, err := uninstallHook(hooksDir, name)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if ok {
+					removed++
+					ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Removed %s hook", ui.Bold(name)))
+				}
+			}
+
+			if removed == 0 {
+				ui.PrintWarning(ui.EmojiWarning, "No tmpo hooks installed in this repository.")
+			}
+
+			ui.NewlineBelow()
+		},
+	}
+
+	return cmd
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/DylanDevelops/tmpo/internal/billing"
 	"github.com/DylanDevelops/tmpo/internal/export"
 	"github.com/DylanDevelops/tmpo/internal/project"
 	"github.com/DylanDevelops/tmpo/internal/settings"
@@ -27,7 +29,10 @@ func ExportCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "export",
 		Short: "Export time entries",
-		Long:  `Export time tracking data to different formats.`,
+		Long: `Export time tracking data to different formats.
+
+Expenses in the same period or project are written to a second file next to
+the entries, named like the export with an "-expenses" suffix.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			ui.NewlineAbove()
 
@@ -40,6 +45,9 @@ func ExportCmd() *cobra.Command {
 			defer db.Close()
 
 			var entries []*storage.TimeEntry
+			var expenseFilter storage.ExpenseFilter
+			// expenses aren't tied to milestones
+			includeExpenses := exportMilestone == ""
 
 			if exportMilestone != "" {
 				// if --project flag is used, ensure global project config is used
@@ -57,6 +65,7 @@ func ExportCmd() *cobra.Command {
 				start := time.Now().Truncate(24 * time.Hour)
 				end := start.Add(24 * time.Hour)
 				entries, err = db.GetEntriesByDateRange(start, end)
+				expenseFilter.Start, expenseFilter.End = &start, &end
 			} else if exportWeek {
 				now := time.Now()
 				weekday := int(now.Weekday())
@@ -67,8 +76,10 @@ func ExportCmd() *cobra.Command {
 				start := now.AddDate(0, 0, -weekday+1).Truncate(24 * time.Hour)
 				end := start.AddDate(0, 0, 7)
 				entries, err = db.GetEntriesByDateRange(start, end)
+				expenseFilter.Start, expenseFilter.End = &start, &end
 			} else if exportProject != "" {
 				entries, err = db.GetEntriesByProject(exportProject)
+				expenseFilter.Project = exportProject
 			} else {
 				entries, err = db.GetEntries(0) // all
 			}
@@ -78,7 +89,21 @@ func ExportCmd() *cobra.Command {
 				os.Exit(1)
 			}
 
-			if len(entries) == 0 {
+			if err := db.LoadEntryCommits(entries); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			var expenses []*storage.Expense
+			if includeExpenses {
+				expenses, err = db.FindExpenses(expenseFilter)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+			}
+
+			if len(entries) == 0 && len(expenses) == 0 {
 				ui.PrintWarning(ui.EmojiWarning, "No entries to export.")
 				ui.NewlineBelow()
 				os.Exit(0)
@@ -139,11 +164,29 @@ func ExportCmd() *cobra.Command {
 				filename = filepath.Join(exportPath, filepath.Base(filename))
 			}
 
+			ext := filepath.Ext(filename)
+			expensesFilename := strings.TrimSuffix(filename, ext) + "-expenses" + ext
+
+			lookup := func(projectName string) (string, billing.Terms) {
+				projectSettings := project.LoadProjectSettings(projectName)
+				return projectSettings.CurrencyCode(), projectSettings.Terms()
+			}
+
 			switch exportFormat {
 			case "csv":
-				err = export.ToCSV(entries, filename)
+				if len(entries) > 0 {
+					err = export.ToCSV(entries, filename, lookup)
+				}
+				if err == nil && len(expenses) > 0 {
+					err = export.ExpensesToCSV(expenses, expensesFilename)
+				}
 			case "json":
-				err = export.ToJson(entries, filename)
+				if len(entries) > 0 {
+					err = export.ToJson(entries, filename, lookup)
+				}
+				if err == nil && len(expenses) > 0 {
+					err = export.ExpensesToJson(expenses, expensesFilename)
+				}
 			default:
 				ui.PrintError(ui.EmojiError, fmt.Sprintf("Unknown format '%s'. Use 'csv' or 'json'", exportFormat))
 				os.Exit(1)
@@ -154,7 +197,13 @@ func ExportCmd() *cobra.Command {
 				os.Exit(1)
 			}
 
-			ui.PrintSuccess(ui.EmojiExport, fmt.Sprintf("Exported %s to %s", ui.Bold(fmt.Sprintf("%d entries", len(entries))), ui.Bold(filename)))
+			if len(entries) > 0 {
+				ui.PrintSuccess(ui.EmojiExport, fmt.Sprintf("Exported %s to %s", ui.Bold(fmt.Sprintf("%d entries", len(entries))), ui.Bold(filename)))
+			}
+
+			if len(expenses) > 0 {
+				ui.PrintSuccess(ui.EmojiExport, fmt.Sprintf("Exported %s to %s", ui.Bold(fmt.Sprintf("%d expenses", len(expenses))), ui.Bold(expensesFilename)))
+			}
 
 			ui.NewlineBelow()
 		},
@@ -0,0 +1,125 @@
+package history
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/goals"
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+)
+
+type goalPeriod int
+
+const (
+	goalPeriodDay goalPeriod = iota
+	goalPeriodWeek
+	goalPeriodAllTime
+)
+
+// showGoalStats prints progress towards the configured daily/weekly goals for
+// the given period along with the current streak of days meeting the daily goal.
+// Nothing is printed when no goals are configured.
+func showGoalStats(db *storage.Database, periodEntries []*storage.TimeEntry, period goalPeriod) {
+	globalCfg, err := settings.LoadGlobalConfig()
+	if err != nil {
+		return
+	}
+
+	now := time.Now().In(settings.GetDisplayTimezone())
+
+	type projectGoal struct {
+		name   string
+		daily  *float64
+		weekly *float64
+	}
+
+	var projectGoals []projectGoal
+	projectNames := make(map[string]bool)
+	for _, entry := range periodEntries {
+		projectNames[entry.ProjectName] = true
+	}
+
+	var names []string
+	for name := range projectNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		daily, weekly := project.GetProjectGoals(name)
+		if daily != nil || weekly != nil {
+			projectGoals = append(projectGoals, projectGoal{name: name, daily: daily, weekly: weekly})
+		}
+	}
+
+	if globalCfg.DailyGoalHours <= 0 && globalCfg.WeeklyGoalHours <= 0 && len(projectGoals) == 0 {
+		return
+	}
+
+	// streaks need the full history rather than just the period being shown
+	allEntries, err := db.GetEntries(0)
+	if err != nil {
+		return
+	}
+
+	ui.PrintSuccess(ui.EmojiMilestone, "Goals")
+
+	printProgress := func(label string, goalHours float64, entries []*storage.TimeEntry, start, end time.Time) {
+		progress := goals.NewProgress(goalHours, goals.TrackedBetween(entries, start, end))
+		status := ui.Muted(fmt.Sprintf("%s remaining", ui.FormatDuration(progress.Remaining())))
+		if progress.Met() {
+			status = ui.Success("goal met")
+		}
+		ui.PrintInfo(4, ui.Bold(label), fmt.Sprintf("%s %s", progress.String(), status))
+	}
+
+	printStreak := func(label string, goalHours float64, entries []*storage.TimeEntry) {
+		current, longest := goals.Streak(entries, goalHours, now)
+		ui.PrintInfo(4, ui.Bold(label), fmt.Sprintf("%s (longest: %s)", formatDays(current), formatDays(longest)))
+	}
+
+	dayStart := goals.StartOfDay(now)
+	weekStart := goals.StartOfWeek(now)
+
+	if period == goalPeriodDay && globalCfg.DailyGoalHours > 0 {
+		printProgress("Daily Goal", globalCfg.DailyGoalHours, allEntries, dayStart, dayStart.AddDate(0, 0, 1))
+	}
+
+	if period == goalPeriodWeek && globalCfg.WeeklyGoalHours > 0 {
+		printProgress("Weekly Goal", globalCfg.WeeklyGoalHours, allEntries, weekStart, weekStart.AddDate(0, 0, 7))
+	}
+
+	if globalCfg.DailyGoalHours > 0 {
+		printStreak("Streak", globalCfg.DailyGoalHours, allEntries)
+	}
+
+	for _, pg := range projectGoals {
+		entries := goals.FilterByProject(allEntries, pg.name)
+
+		if period == goalPeriodDay && pg.daily != nil {
+			printProgress(fmt.Sprintf("%s Daily Goal", pg.name), *pg.daily, entries, dayStart, dayStart.AddDate(0, 0, 1))
+		}
+
+		if period == goalPeriodWeek && pg.weekly != nil {
+			printProgress(fmt.Sprintf("%s Weekly Goal", pg.name), *pg.weekly, entries, weekStart, weekStart.AddDate(0, 0, 7))
+		}
+
+		if pg.daily != nil {
+			printStreak(fmt.Sprintf("%s Streak", pg.name), *pg.daily, entries)
+		}
+	}
+
+	ui.NewlineBelow()
+}
+
+func formatDays(days int) string {
+	if days == 1 {
+		return "1 day"
+	}
+
+	return fmt.Sprintf("%d days", days)
+}
@@ -3,6 +3,7 @@ package history
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/DylanDevelops/tmpo/internal/project"
@@ -18,6 +19,7 @@ var (
 	logMilestone string
 	logToday     bool
 	logWeek      bool
+	logVerbose   bool
 )
 
 func LogCmd() *cobra.Command {
@@ -76,6 +78,11 @@ func LogCmd() *cobra.Command {
 				os.Exit(1)
 			}
 
+			if err := db.LoadEntryCommits(entries); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
 			if len(entries) == 0 {
 				ui.PrintWarning(ui.EmojiWarning, "No time entries found.")
 				ui.NewlineBelow()
@@ -85,46 +92,7 @@ func LogCmd() *cobra.Command {
 			ui.PrintSuccess(ui.EmojiLog, fmt.Sprintf("Time Entries (%d total)", len(entries)))
 			fmt.Println()
 
-			var totalDuration time.Duration
-			currentDate := ""
-
-			for _, entry := range entries {
-				entryDate := settings.FormatDateLong(entry.StartTime)
-				if entryDate != currentDate {
-					if currentDate != "" {
-						fmt.Println()
-					}
-
-					fmt.Println(ui.Bold(ui.Muted(fmt.Sprintf("─── %s ───", entryDate))))
-					currentDate = entryDate
-				}
-
-				duration := entry.Duration()
-				totalDuration += duration
-
-				timeRange := settings.FormatTimePadded(entry.StartTime) + " - "
-				if entry.EndTime != nil {
-					timeRange += settings.FormatTimePadded(*entry.EndTime) + "  "
-				} else {
-					timeRange += ui.Warning("(running)") + " "
-				}
-
-				fmt.Printf("  %s  %s  %s\n", timeRange, ui.Bold(fmt.Sprintf("%-20s", entry.ProjectName)), ui.FormatDuration(duration))
-				if entry.MilestoneName != nil {
-					symbol := "└─"
-					if entry.Description != "" {
-						symbol = "├─"
-					}
-					fmt.Printf("    %s %s %s\n", ui.Muted(symbol), ui.Muted("Milestone:"), *entry.MilestoneName)
-				}
-				if entry.Description != "" {
-					fmt.Printf("    %s %s\n", ui.Muted("└─"), entry.Description)
-				}
-			}
-
-			fmt.Println()
-			ui.PrintSeparator()
-			fmt.Printf("%s %s\n", ui.BoldInfo("Total Time:"), ui.Bold(ui.FormatDuration(totalDuration)))
+			printEntryLog(entries, nil, logVerbose)
 
 			ui.NewlineBelow()
 		},
@@ -135,6 +103,97 @@ func LogCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&logMilestone, "milestone", "m", "", "Filter by milestone")
 	cmd.Flags().BoolVarP(&logToday, "today", "t", false, "Show today's entries")
 	cmd.Flags().BoolVarP(&logWeek, "week", "w", false, "Show this week's entries")
+	cmd.Flags().BoolVarP(&logVerbose, "verbose", "v", false, "Show entry notes")
 
 	return cmd
 }
+
+// entryText replaces the tags and description printed for an entry, e.g. to
+// show them with search matches highlighted. Notes are printed when set.
+type entryText struct {
+	tags        string
+	description string
+	notes       string
+}
+
+// printEntryLog prints entries grouped by day followed by their total time.
+// Entry notes are only included when verbose is set.
+func printEntryLog(entries []*storage.TimeEntry, texts map[int64]entryText, verbose bool) {
+	var totalDuration time.Duration
+	currentDate := ""
+
+	for _, entry := range entries {
+		entryDate := settings.FormatDateLong(entry.StartTime)
+		if entryDate != currentDate {
+			if currentDate != "" {
+				fmt.Println()
+			}
+
+			fmt.Println(ui.Bold(ui.Muted(fmt.Sprintf("─── %s ───", entryDate))))
+			currentDate = entryDate
+		}
+
+		duration := entry.Duration()
+		totalDuration += duration
+
+		timeRange := settings.FormatTimePadded(entry.StartTime) + " - "
+		if entry.EndTime != nil {
+			timeRange += settings.FormatTimePadded(*entry.EndTime) + "  "
+		} else {
+			timeRange += ui.Warning("(running)") + " "
+		}
+
+		fmt.Printf("  %s  %s  %-12s %s\n", timeRange, ui.Bold(fmt.Sprintf("%-20s", entry.ProjectName)), ui.FormatDuration(duration), ui.Muted(fmt.Sprintf("#%d", entry.ID)))
+
+		var details []string
+		if entry.IssueKey != "" {
+			details = append(details, fmt.Sprintf("%s %s", ui.Muted("Issue:"), entry.IssueKey))
+		}
+		if entry.MilestoneName != nil {
+			details = append(details, fmt.Sprintf("%s %s", ui.Muted("Milestone:"), *entry.MilestoneName))
+		}
+		if !entry.Billable {
+			details = append(details, ui.Muted("Non-billable"))
+		}
+		tags, description, notes := strings.Join(entry.Tags, ", "), entry.Description, ""
+		if verbose {
+			notes = entry.Notes
+		}
+		if text, ok := texts[entry.ID]; ok {
+			tags, description = text.tags, text.description
+			if text.notes != "" {
+				notes = text.notes
+			}
+		}
+		if tags != "" {
+			details = append(details, fmt.Sprintf("%s %s", ui.Muted("Tags:"), tags))
+		}
+		if description != "" {
+			details = append(details, description)
+		}
+		if notes != "" {
+			details = append(details, ui.Muted("Notes:")+"\n"+notes)
+		}
+		for _, commit := range entry.Commits {
+			details = append(details, fmt.Sprintf("%s %s", ui.Muted(commit.ShortSHA()), commit.Message))
+		}
+
+		for i, detail := range details {
+			symbol, continuation := "├─", "│ "
+			if i == len(details)-1 {
+				symbol, continuation = "└─", "  "
+			}
+
+			// multi-line details such as notes continue under their branch
+			lines := strings.Split(strings.TrimRight(detail, "\n"), "\n")
+			fmt.Printf("    %s %s\n", ui.Muted(symbol), lines[0])
+			for _, line := range lines[1:] {
+				fmt.Printf("    %s %s\n", ui.Muted(continuation), line)
+			}
+		}
+	}
+
+	fmt.Println()
+	ui.PrintSeparator()
+	fmt.Printf("%s %s\n", ui.BoldInfo("Total Time:"), ui.Bold(ui.FormatDuration(totalDuration)))
+}
@@ -0,0 +1,23 @@
+package history
+
+import (
+	"fmt"
+
+	"github.com/DylanDevelops/tmpo/internal/pomodoro"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+)
+
+// showPomodoroStats prints how many pomodoros were completed in the given
+// entries. Nothing is printed when there are none.
+func showPomodoroStats(entries []*storage.TimeEntry) {
+	summary := pomodoro.Summarize(entries)
+	if summary.Completed == 0 {
+		return
+	}
+
+	ui.PrintSuccess(ui.EmojiPomodoro, "Pomodoros")
+	ui.PrintInfo(4, ui.Bold("Completed"), fmt.Sprintf("%d", summary.Completed))
+	ui.PrintInfo(4, ui.Bold("Focus Time"), ui.FormatDuration(summary.Focus))
+	ui.NewlineBelow()
+}
@@ -0,0 +1,192 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/export"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	searchProject   string
+	searchMilestone string
+	searchTag       string
+	searchRange     string
+	searchLimit     int
+	searchJSON      bool
+)
+
+// searchResult is a search match in --json output.
+type searchResult struct {
+	ID int64 `json:"id"`
+	export.ExportEntry
+	Tags  []string `json:"tags,omitempty"`
+	Score float64  `json:"score"`
+}
+
+func SearchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search time entries",
+		Long: `Search entry descriptions, tags and notes. The best matches are shown by day
+like 'tmpo log', and --json lists them best first with their score.
+
+Every word must match, and words match as prefixes so "auth" also finds
+"authentication". Put text in quotes to match it as a phrase and prefix a word
+with - to exclude entries containing it.
+
+Examples:
+  tmpo search oauth
+  tmpo search "login flow" -bug --project web
+  tmpo search refactor --range last-month --json`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if !searchJSON {
+				ui.NewlineAbove()
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			defer db.Close()
+
+			filter := storage.EntryFilter{
+				Project:   searchProject,
+				Milestone: searchMilestone,
+				Tag:       searchTag,
+			}
+
+			if searchRange != "" {
+				filter.Start, filter.End, err = settings.ParseDateRange(searchRange, settings.DateLayoutDashed(), time.Now())
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid range: %v", err))
+					os.Exit(1)
+				}
+			}
+
+			results, err := db.SearchEntries(strings.Join(args, " "), filter, searchLimit)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			entries := make([]*storage.TimeEntry, len(results))
+			for i, result := range results {
+				entries[i] = result.Entry
+			}
+
+			if err := db.LoadEntryCommits(entries); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if searchJSON {
+				if err := printSearchJSON(results); err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+				return
+			}
+
+			if len(results) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, "No matching time entries found.")
+				ui.NewlineBelow()
+				return
+			}
+
+			ui.PrintSuccess(ui.EmojiSearch, fmt.Sprintf("Search Results (%d found)", len(results)))
+			fmt.Println()
+
+			texts := make(map[int64]entryText, len(results))
+			for _, result := range results {
+				text := entryText{
+					tags:        highlightMatches(strings.ReplaceAll(result.Tags, ",", ", ")),
+					description: highlightMatches(result.Description),
+				}
+
+				// notes are long, so only show them when they matched
+				if strings.Contains(result.Notes, storage.HighlightStart) {
+					text.notes = highlightMatches(result.Notes)
+				}
+
+				texts[result.Entry.ID] = text
+			}
+
+			// the log groups entries by day, so show the best matches in date order
+			sort.SliceStable(entries, func(i, j int) bool {
+				return entries[i].StartTime.After(entries[j].StartTime)
+			})
+
+			printEntryLog(entries, texts, false)
+
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().StringVarP(&searchProject, "project", "p", "", "Only search entries of this project")
+	cmd.Flags().StringVarP(&searchMilestone, "milestone", "m", "", "Only search entries assigned to this milestone")
+	cmd.Flags().StringVarP(&searchTag, "tag", "t", "", "Only search entries with this tag")
+	cmd.Flags().StringVarP(&searchRange, "range", "r", "", "Only search entries started in this range (today, week, last-week, month, last-month, DATE or FROM..TO)")
+	cmd.Flags().IntVarP(&searchLimit, "limit", "l", 20, "Maximum number of results (0 for all)")
+	cmd.Flags().BoolVar(&searchJSON, "json", false, "Print results as JSON")
+
+	return cmd
+}
+
+// highlightMatches renders the match markers added by the search index.
+func highlightMatches(text string) string {
+	var b strings.Builder
+
+	for {
+		start := strings.Index(text, storage.HighlightStart)
+		if start < 0 {
+			break
+		}
+
+		end := strings.Index(text[start:], storage.HighlightEnd)
+		if end < 0 {
+			break
+		}
+		end += start
+
+		b.WriteString(text[:start])
+		b.WriteString(ui.BoldWarning(text[start+len(storage.HighlightStart) : end]))
+		text = text[end+len(storage.HighlightEnd):]
+	}
+
+	b.WriteString(text)
+
+	return b.String()
+}
+
+func printSearchJSON(results []*storage.SearchResult) error {
+	output := make([]searchResult, len(results))
+	for i, result := range results {
+		output[i] = searchResult{
+			ID:          result.Entry.ID,
+			ExportEntry: export.NewExportEntry(result.Entry),
+			Tags:        result.Entry.Tags,
+			Score:       result.Score,
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(output); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+
+	return nil
+}
@@ -4,25 +4,36 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 	"time"
 
-	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/billing"
 	"github.com/DylanDevelops/tmpo/internal/currency"
+	"github.com/DylanDevelops/tmpo/internal/goals"
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/retainer"
+	"github.com/DylanDevelops/tmpo/internal/settings"
 	"github.com/DylanDevelops/tmpo/internal/storage"
 	"github.com/DylanDevelops/tmpo/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 var (
-	statsToday bool
-	statsWeek bool
+	statsToday           bool
+	statsWeek            bool
+	statsIncludeArchived bool
+	statsCurrency        string
 )
 
 func StatsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "stats",
 		Short: "Show time tracking statistics",
-		Long:  `Display statistics and summaries of your time tracking data.`,
+		Long: `Display statistics and summaries of your time tracking data.
+
+Earnings and expenses are shown per currency and, when projects are billed
+in different currencies, converted to your reporting currency using the
+exchange rates set with 'tmpo fx'.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			ui.NewlineAbove()
 
@@ -34,24 +45,46 @@ func StatsCmd() *cobra.Command {
 
 			defer db.Close()
 
+			globalCfg, err := settings.LoadGlobalConfig()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("loading config: %v", err))
+				os.Exit(1)
+			}
+
+			reportingCurrency := statsCurrency
+			if reportingCurrency == "" {
+				reportingCurrency = globalCfg.GetReportingCurrency()
+			}
+
+			converter, err := newEarningsConverter(db, reportingCurrency, globalCfg.CurrencyFormat())
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			retainers, err := retainer.LoadAll(db)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
 			var start, end time.Time
 			var periodName string
+			var period goalPeriod
+
+			// periods follow the display timezone, like goals and date ranges
+			now := time.Now().In(settings.GetDisplayTimezone())
 
 			if statsToday {
-				now := time.Now()
-				start = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).UTC()
-				end = start.Add(24 * time.Hour)
+				start = goals.StartOfDay(now)
+				end = start.AddDate(0, 0, 1)
 				periodName = "Today"
+				period = goalPeriodDay
 			} else if statsWeek {
-				now := time.Now()
-				weekday := int(now.Weekday())
-				if weekday == 0 {
-					weekday = 7
-				}
-
-				start = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).UTC().AddDate(0, 0, -weekday+1)
+				start = goals.StartOfWeek(now)
 				end = start.AddDate(0, 0, 7)
 				periodName = "This Week"
+				period = goalPeriodWeek
 			} else {
 				entries, err := db.GetEntries(0)
 				if err != nil {
@@ -59,7 +92,22 @@ func StatsCmd() *cobra.Command {
 					os.Exit(1)
 				}
 
-				ShowAllTimeStats(entries, db)
+				expenses, err := db.FindExpenses(storage.ExpenseFilter{})
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				visible := entries
+				if !statsIncludeArchived {
+					visible = excludeArchived(db, entries)
+					expenses = excludeArchivedExpenses(db, expenses)
+				}
+
+				ShowAllTimeStats(visible, expenses, retainers, converter)
+				showPomodoroStats(visible)
+				// goals count all work, matching 'tmpo status'
+				showGoalStats(db, entries, goalPeriodAllTime)
 				return
 			}
 
@@ -69,139 +117,439 @@ func StatsCmd() *cobra.Command {
 				os.Exit(1)
 			}
 
-			ShowPeriodStats(entries, periodName)
+			expenses, err := db.FindExpenses(storage.ExpenseFilter{Start: &start, End: &end})
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			visible := entries
+			if !statsIncludeArchived {
+				visible = excludeArchived(db, entries)
+				expenses = excludeArchivedExpenses(db, expenses)
+			}
+
+			ShowPeriodStats(visible, expenses, retainers, periodName, converter)
+			showPomodoroStats(visible)
+			showGoalStats(db, entries, period)
 		},
 	}
 
 	cmd.Flags().BoolVarP(&statsToday, "today", "t", false, "Show today's stats")
 	cmd.Flags().BoolVarP(&statsWeek, "week", "w", false, "Show this week's stats")
+	cmd.Flags().BoolVar(&statsIncludeArchived, "include-archived", false, "Include archived projects")
+	cmd.Flags().StringVar(&statsCurrency, "currency", "", "Convert earnings to this currency (default: your reporting currency)")
 
 	return cmd
 }
 
-func ShowPeriodStats(entries []*storage.TimeEntry, periodName string) {
-	if len(entries) == 0 {
+func ShowPeriodStats(entries []*storage.TimeEntry, expenses []*storage.Expense, retainers map[string]*retainer.Balance, periodName string, converter *earningsConverter) {
+	if len(entries) == 0 && len(expenses) == 0 {
 		ui.PrintWarning(ui.EmojiWarning, fmt.Sprintf("No entries for %s.", periodName))
 		ui.NewlineBelow()
 		return
 	}
 
-	projectStats := make(map[string]time.Duration)
-	projectEarnings := make(map[string]float64)
-	var totalDuration time.Duration
-	var totalEarnings float64
-	hasAnyEarnings := false
-
-	for _, entry := range entries {
-		duration := entry.Duration()
-		projectStats[entry.ProjectName] += duration
-		totalDuration += duration
-
-		if entry.HourlyRate != nil {
-			earnings := entry.RoundedHours() * *entry.HourlyRate
-			projectEarnings[entry.ProjectName] += earnings
-			totalEarnings += earnings
-			hasAnyEarnings = true
-		}
-	}
-
-	currencyCode := getCurrencyCode()
+	stats := aggregateStats(entries, expenses, converter)
 
 	ui.PrintSuccess(ui.EmojiStats, fmt.Sprintf("Stats for %s", ui.Bold(periodName)))
 	fmt.Println()
-	ui.PrintInfo(4, ui.Bold("Total Time"), fmt.Sprintf("%s (%.2f hours)", ui.FormatDuration(totalDuration), totalDuration.Hours()))
+	ui.PrintInfo(4, ui.Bold("Total Time"), fmt.Sprintf("%s (%.2f hours)", ui.FormatDuration(stats.totalDuration), stats.totalDuration.Hours()))
 	ui.PrintInfo(4, ui.Bold("Total Entries"), fmt.Sprintf("%d", len(entries)))
+	printBillableTime(stats.billableDuration, stats.totalDuration)
+
+	printEarnings(converter.format, stats.earnings, converter.reporting)
+	printTotal(converter.format, "Expenses", stats.expenses, converter.reporting, grossAmount)
+
+	fmt.Println()
+	ui.PrintInfo(4, ui.Bold("By Project"), "")
+	printProjectBreakdown(stats, retainers, converter)
 
-	if hasAnyEarnings {
-		ui.PrintInfo(4, ui.Bold("Earnings"), currency.FormatCurrency(totalEarnings, currencyCode))
+	ui.NewlineBelow()
+}
+
+func ShowAllTimeStats(entries []*storage.TimeEntry, expenses []*storage.Expense, retainers map[string]*retainer.Balance, converter *earningsConverter) {
+	if len(entries) == 0 && len(expenses) == 0 {
+		ui.PrintWarning(ui.EmojiWarning, "No entries found.")
+		ui.NewlineBelow()
+		return
 	}
 
+	stats := aggregateStats(entries, expenses, converter)
+
+	ui.PrintSuccess(ui.EmojiStats, ui.Bold("All-Time Statistics"))
+	ui.PrintInfo(4, ui.Bold("Total Time"), fmt.Sprintf("%s (%.2f hours)", ui.FormatDuration(stats.totalDuration), stats.totalDuration.Hours()))
+	ui.PrintInfo(4, ui.Bold("Total Entries"), fmt.Sprintf("%d", len(entries)))
+	ui.PrintInfo(4, ui.Bold("Projects Tracked"), fmt.Sprintf("%d", len(stats.byProject)))
+	printBillableTime(stats.billableDuration, stats.totalDuration)
+
+	printEarnings(converter.format, stats.earnings, converter.reporting)
+	printTotal(converter.format, "Expenses", stats.expenses, converter.reporting, grossAmount)
+
 	fmt.Println()
 	ui.PrintInfo(4, ui.Bold("By Project"), "")
+	printProjectBreakdown(stats, retainers, converter)
+
+	ui.NewlineBelow()
+}
+
+// periodStats sums tracked time, earnings and expenses overall and per project.
+type periodStats struct {
+	byProject          map[string]time.Duration
+	nonBillable        map[string]time.Duration
+	projectEarnings    map[string]billing.Amounts
+	projectExpenses    map[string]map[string]float64
+	totalDuration      time.Duration
+	billableDuration   time.Duration
+	earnings, expenses *earningsTotal
+}
+
+func aggregateStats(entries []*storage.TimeEntry, expenses []*storage.Expense, converter *earningsConverter) *periodStats {
+	stats := &periodStats{
+		byProject:       make(map[string]time.Duration),
+		nonBillable:     make(map[string]time.Duration),
+		projectEarnings: make(map[string]billing.Amounts),
+		earnings:        newEarningsTotal(),
+	}
+
+	for _, entry := range entries {
+		duration := entry.Duration()
+		stats.byProject[entry.ProjectName] += duration
+		stats.totalDuration += duration
+
+		if entry.Billable {
+			stats.billableDuration += duration
+		} else {
+			stats.nonBillable[entry.ProjectName] += duration
+		}
 
+		// only billable time earns money
+		if entry.IsBilled() {
+			stats.projectEarnings[entry.ProjectName] = stats.projectEarnings[entry.ProjectName].Add(converter.add(stats.earnings, entry))
+		}
+	}
+
+	stats.expenses, stats.projectExpenses = converter.addExpenses(expenses)
+	for project := range stats.projectExpenses {
+		if _, ok := stats.byProject[project]; !ok {
+			stats.byProject[project] = 0
+		}
+	}
+
+	return stats
+}
+
+// printProjectBreakdown prints each project's share of the tracked time with
+// its billing details below it.
+func printProjectBreakdown(stats *periodStats, retainers map[string]*retainer.Balance, converter *earningsConverter) {
 	var projects []string
-	for project := range projectStats {
+	for project := range stats.byProject {
 		projects = append(projects, project)
 	}
 	sort.Strings(projects)
 
 	for _, project := range projects {
-		duration := projectStats[project]
-		percentage := (duration.Seconds() / totalDuration.Seconds()) * 100
+		duration := stats.byProject[project]
+		percentage := 0.0
+		if stats.totalDuration > 0 {
+			percentage = (duration.Seconds() / stats.totalDuration.Seconds()) * 100
+		}
 		fmt.Printf("        %s  %s  (%.1f%%)\n", ui.Bold(fmt.Sprintf("%-20s", project)), ui.FormatDuration(duration), percentage)
 
-		if earnings, ok := projectEarnings[project]; ok && earnings > 0 {
-			fmt.Printf("        %s %s\n", ui.Muted("└─ Earnings:"), currency.FormatCurrency(earnings, currencyCode))
-		}
+		printProjectBilling(converter.format, stats.nonBillable[project], stats.projectEarnings[project], converter.currency(project), stats.projectExpenses[project], retainers[project])
+	}
+}
+
+// printBillableTime shows how tracked time splits into billable and
+// non-billable time. Utilization is the billable share of all tracked time.
+func printBillableTime(billable, total time.Duration) {
+	nonBillable := total - billable
+
+	utilization := 0.0
+	if total > 0 {
+		utilization = billable.Seconds() / total.Seconds() * 100
 	}
 
-	ui.NewlineBelow()
+	ui.PrintInfo(4, ui.Bold("Billable"), fmt.Sprintf("%s (%.2f hours)", ui.FormatDuration(billable), billable.Hours()))
+	ui.PrintInfo(4, ui.Bold("Non-billable"), fmt.Sprintf("%s (%.2f hours)", ui.FormatDuration(nonBillable), nonBillable.Hours()))
+	ui.PrintInfo(4, ui.Bold("Utilization"), fmt.Sprintf("%.1f%%", utilization))
 }
 
-func ShowAllTimeStats(entries []*storage.TimeEntry, db *storage.Database) {
-	if len(entries) == 0 {
-		ui.PrintWarning(ui.EmojiWarning, "No entries found.")
-		ui.NewlineBelow()
-		return
+// printProjectBilling prints a project's non-billable time, earnings,
+// expenses and retainer balance below its line in the per-project breakdown.
+func printProjectBilling(format currency.Format, nonBillable time.Duration, earnings billing.Amounts, currencyCode string, expenses map[string]float64, balance *retainer.Balance) {
+	var details []string
+	if nonBillable > 0 {
+		details = append(details, fmt.Sprintf("%s %s", ui.Muted("Non-billable:"), ui.FormatDuration(nonBillable)))
+	}
+	if earnings.Subtotal > 0 {
+		details = append(details, fmt.Sprintf("%s %s", ui.Muted("Earnings:"), format.FormatCurrency(earnings.Subtotal, currencyCode)))
+	}
+	if hasTerms(earnings) {
+		details = append(details, fmt.Sprintf("%s %s  %s %s  %s %s",
+			ui.Muted("Net:"), format.FormatCurrency(earnings.Net, currencyCode),
+			ui.Muted("Tax:"), format.FormatCurrency(earnings.Tax, currencyCode),
+			ui.Muted("Gross:"), format.FormatCurrency(earnings.Gross, currencyCode)))
 	}
+	if len(expenses) > 0 {
+		details = append(details, fmt.Sprintf("%s %s", ui.Muted("Expenses:"), formatAmounts(format, expenses)))
+	}
+	if balance != nil {
+		remaining := balance.String()
+		if balance.Remaining() >= 0 && balance.IsLow() {
+			remaining += " (running low)"
+		}
+		if balance.IsLow() {
+			remaining = ui.Warning(remaining)
+		}
+		details = append(details, fmt.Sprintf("%s %s", ui.Muted("Retainer:"), remaining))
+	}
+
+	for i, detail := range details {
+		symbol := "├─"
+		if i == len(details)-1 {
+			symbol = "└─"
+		}
+		fmt.Printf("        %s %s\n", ui.Muted(symbol), detail)
+	}
+}
 
-	projectStats := make(map[string]time.Duration)
-	projectEarnings := make(map[string]float64)
-	var totalDuration time.Duration
-	var totalEarnings float64
-	hasAnyEarnings := false
+// excludeArchived drops entries of archived projects.
+func excludeArchived(db *storage.Database, entries []*storage.TimeEntry) []*storage.TimeEntry {
+	archived, err := db.GetArchivedProjects()
+	if err != nil || len(archived) == 0 {
+		return entries
+	}
 
+	var visible []*storage.TimeEntry
 	for _, entry := range entries {
-		duration := entry.Duration()
-		projectStats[entry.ProjectName] += duration
-		totalDuration += duration
-
-		if entry.HourlyRate != nil {
-			earnings := entry.RoundedHours() * *entry.HourlyRate
-			projectEarnings[entry.ProjectName] += earnings
-			totalEarnings += earnings
-			hasAnyEarnings = true
+		if _, ok := archived[entry.ProjectName]; !ok {
+			visible = append(visible, entry)
 		}
 	}
 
-	allProjects, _ := db.GetAllProjects()
-	currencyCode := getCurrencyCode()
+	return visible
+}
 
-	ui.PrintSuccess(ui.EmojiStats, ui.Bold("All-Time Statistics"))
-	ui.PrintInfo(4, ui.Bold("Total Time"), fmt.Sprintf("%s (%.2f hours)", ui.FormatDuration(totalDuration), totalDuration.Hours()))
-	ui.PrintInfo(4, ui.Bold("Total Entries"), fmt.Sprintf("%d", len(entries)))
-	ui.PrintInfo(4, ui.Bold("Projects Tracked"), fmt.Sprintf("%d", len(allProjects)))
+// excludeArchivedExpenses drops expenses of archived projects.
+func excludeArchivedExpenses(db *storage.Database, expenses []*storage.Expense) []*storage.Expense {
+	archived, err := db.GetArchivedProjects()
+	if err != nil || len(archived) == 0 {
+		return expenses
+	}
 
-	if hasAnyEarnings {
-		ui.PrintInfo(4, ui.Bold("Earnings"), currency.FormatCurrency(totalEarnings, currencyCode))
+	var visible []*storage.Expense
+	for _, expense := range expenses {
+		if _, ok := archived[expense.ProjectName]; !ok {
+			visible = append(visible, expense)
+		}
 	}
 
-	fmt.Println()
-	ui.PrintInfo(4, ui.Bold("By Project"), "")
+	return visible
+}
 
-	var projects []string
-	for project := range projectStats {
-		projects = append(projects, project)
+// earningsTotal sums billed amounts per currency, and converted to the
+// reporting currency.
+type earningsTotal struct {
+	byCurrency  map[string]billing.Amounts
+	converted   billing.Amounts
+	unconverted map[string]bool // currencies without an exchange rate
+}
+
+func newEarningsTotal() *earningsTotal {
+	return &earningsTotal{
+		byCurrency:  make(map[string]billing.Amounts),
+		unconverted: make(map[string]bool),
 	}
-	sort.Strings(projects)
+}
 
-	for _, project := range projects {
-		duration := projectStats[project]
-		percentage := (duration.Seconds() / totalDuration.Seconds()) * 100
-		fmt.Printf("        %s  %s  (%.1f%%)\n", ui.Bold(fmt.Sprintf("%-20s", project)), ui.FormatDuration(duration), percentage)
+// earningsConverter converts the earnings of entries from their project's
+// currency to the reporting currency.
+type earningsConverter struct {
+	reporting string
+	format    currency.Format
+	fx        *storage.FXTable
+	projects  map[string]projectBilling
+}
+
+// projectBilling is how a project's earnings are billed.
+type projectBilling struct {
+	currency string
+	terms    billing.Terms
+}
+
+func newEarningsConverter(db *storage.Database, reportingCurrency string, format currency.Format) (*earningsConverter, error) {
+	fx, err := db.LoadFXTable()
+	if err != nil {
+		return nil, err
+	}
+
+	return &earningsConverter{
+		reporting: strings.ToUpper(strings.TrimSpace(reportingCurrency)),
+		format:    format,
+		fx:        fx,
+		projects:  make(map[string]projectBilling),
+	}, nil
+}
+
+// lookup returns the currency and terms of a project, loading its settings
+// the first time it is seen.
+func (c *earningsConverter) lookup(projectName string) projectBilling {
+	billed, ok := c.projects[projectName]
+	if !ok {
+		projectSettings := project.LoadProjectSettings(projectName)
+		billed = projectBilling{currency: projectSettings.CurrencyCode(), terms: projectSettings.Terms()}
+		c.projects[projectName] = billed
+	}
 
-		if earnings, ok := projectEarnings[project]; ok && earnings > 0 {
-			fmt.Printf("        %s %s\n", ui.Muted("└─ Earnings:"), currency.FormatCurrency(earnings, currencyCode))
+	return billed
+}
+
+// currency returns the currency a project is billed in.
+func (c *earningsConverter) currency(projectName string) string {
+	return c.lookup(projectName).currency
+}
+
+// add adds an entry's earnings after its project's discount and tax to
+// total, converted at the exchange rate of the day the entry started, and
+// returns them.
+func (c *earningsConverter) add(total *earningsTotal, entry *storage.TimeEntry) billing.Amounts {
+	billed := c.lookup(entry.ProjectName)
+	amounts := billed.terms.Apply(entry.Earnings())
+	c.addAmounts(total, amounts, billed.currency, entry.StartTime)
+
+	return amounts
+}
+
+// addExpenses sums expenses in their own currency, converted at the exchange
+// rate of their date, and per project.
+func (c *earningsConverter) addExpenses(expenses []*storage.Expense) (*earningsTotal, map[string]map[string]float64) {
+	total := newEarningsTotal()
+	byProject := make(map[string]map[string]float64)
+
+	for _, expense := range expenses {
+		c.addAmounts(total, billing.Terms{}.Apply(expense.Amount), expense.Currency, expense.Date)
+
+		if byProject[expense.ProjectName] == nil {
+			byProject[expense.ProjectName] = make(map[string]float64)
 		}
+		byProject[expense.ProjectName][expense.Currency] += expense.Amount
 	}
 
-	ui.NewlineBelow()
+	return total, byProject
 }
 
-func getCurrencyCode() string {
-	globalCfg, err := settings.LoadGlobalConfig()
-	if err != nil {
-		return currency.DefaultCurrency
+func (c *earningsConverter) addAmounts(total *earningsTotal, amounts billing.Amounts, code string, at time.Time) {
+	total.byCurrency[code] = total.byCurrency[code].Add(amounts)
+
+	rate, ok := c.fx.Convert(1, code, c.reporting, at)
+	if !ok {
+		total.unconverted[code] = true
+		return
+	}
+
+	total.converted = total.converted.Add(amounts.Scale(rate))
+}
+
+func subtotalAmount(amounts billing.Amounts) float64 { return amounts.Subtotal }
+func discountAmount(amounts billing.Amounts) float64 { return amounts.Discount }
+func netAmount(amounts billing.Amounts) float64      { return amounts.Net }
+func taxAmount(amounts billing.Amounts) float64      { return amounts.Tax }
+func grossAmount(amounts billing.Amounts) float64    { return amounts.Gross }
+
+// hasTerms reports whether a discount or tax changed the amounts.
+func hasTerms(amounts billing.Amounts) bool {
+	return amounts.Discount != 0 || amounts.Tax != 0
+}
+
+// formatAmounts formats amounts in several currencies, e.g. "€200.00 + $450.00".
+func formatAmounts(format currency.Format, byCurrency map[string]float64) string {
+	var codes []string
+	for code := range byCurrency {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	amounts := make([]string, len(codes))
+	for i, code := range codes {
+		amounts[i] = format.FormatCurrency(byCurrency[code], code)
+	}
+
+	return strings.Join(amounts, " + ")
+}
+
+// printEarnings prints earnings per currency. When a discount or tax applies
+// they are followed by the net, tax and gross amounts, and only those are
+// converted to the reporting currency.
+func printEarnings(format currency.Format, total *earningsTotal, reportingCurrency string) {
+	terms := false
+	for _, amounts := range total.byCurrency {
+		terms = terms || hasTerms(amounts)
+	}
+
+	if !terms {
+		printTotal(format, "Earnings", total, reportingCurrency, subtotalAmount)
+		return
+	}
+
+	printTotal(format, "Earnings", total, "", subtotalAmount)
+	if hasDiscount(total) {
+		printTotal(format, "Discount", total, "", discountAmount)
+	}
+	printTotal(format, "Net", total, reportingCurrency, netAmount)
+	printTotal(format, "Tax", total, reportingCurrency, taxAmount)
+	printTotal(format, "Gross", total, reportingCurrency, grossAmount)
+}
+
+func hasDiscount(total *earningsTotal) bool {
+	for _, amounts := range total.byCurrency {
+		if amounts.Discount != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// printTotal prints one of the amounts per currency, followed by their sum
+// in the reporting currency when they aren't all in it already. An empty
+// reporting currency skips the sum.
+func printTotal(format currency.Format, label string, total *earningsTotal, reportingCurrency string, amount func(billing.Amounts) float64) {
+	if len(total.byCurrency) == 0 {
+		return
+	}
+
+	// currencies without a discount or tax are left out of those lines
+	byCurrency := make(map[string]float64, len(total.byCurrency))
+	for code, amounts := range total.byCurrency {
+		if value := amount(amounts); value != 0 {
+			byCurrency[code] = value
+		}
+	}
+	if len(byCurrency) == 0 {
+		for code, amounts := range total.byCurrency {
+			byCurrency[code] = amount(amounts)
+		}
+	}
+	ui.PrintInfo(4, ui.Bold(label), formatAmounts(format, byCurrency))
+
+	if reportingCurrency == "" {
+		return
+	}
+
+	if _, ok := total.byCurrency[reportingCurrency]; ok && len(total.byCurrency) == 1 {
+		return
+	}
+
+	ui.PrintInfo(4, ui.Bold(fmt.Sprintf("%s in %s", label, reportingCurrency)), format.FormatCurrency(amount(total.converted), reportingCurrency))
+
+	if len(total.unconverted) > 0 {
+		var missing []string
+		for code := range total.unconverted {
+			missing = append(missing, code)
+		}
+		sort.Strings(missing)
+
+		ui.PrintMuted(4, fmt.Sprintf("└─ Excludes %s: no exchange rate to %s, add one with 'tmpo fx set'", strings.Join(missing, ", "), reportingCurrency))
 	}
-	return globalCfg.Currency
 }
@@ -46,7 +46,7 @@ func FinishCmd() *cobra.Command {
 			}
 
 			// Get entries for this milestone to show count
-			entries, err := db.GetEntriesByMilestone(projectName, activeMilestone.Name)
+			entries, err := db.GetEntriesByMilestoneID(activeMilestone.ID)
 			if err != nil {
 				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
 				os.Exit(1)
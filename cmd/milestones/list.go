@@ -89,14 +89,20 @@ func ListCmd() *cobra.Command {
 			if len(activeMilestones) > 0 {
 				fmt.Printf("%s Active %s\n", ui.Muted("───"), ui.Muted("───"))
 				for _, m := range activeMilestones {
+					// mark the milestone new entries are tagged with when several are open
+					name := m.Name
+					if current, err := db.GetActiveMilestoneForProject(m.ProjectName); err == nil && current != nil && current.ID == m.ID {
+						name += " " + ui.Muted("(current)")
+					}
+
 					// Get entry count for this milestone
-					entries, _ := db.GetEntriesByMilestone(m.ProjectName, m.Name)
+					entries, _ := db.GetEntriesByMilestoneID(m.ID)
 					entryCount := len(entries)
 
 					if listAll {
-						fmt.Printf("  %s (%s)\n", ui.Bold(m.Name), m.ProjectName)
+						fmt.Printf("  %s (%s)\n", ui.Bold(name), m.ProjectName)
 					} else {
-						fmt.Printf("  %s\n", ui.Bold(m.Name))
+						fmt.Printf("  %s\n", ui.Bold(name))
 					}
 					fmt.Printf("    Started: %s  Duration: %s  Entries: %d\n",
 						settings.FormatTime(m.StartTime),
@@ -111,7 +117,7 @@ func ListCmd() *cobra.Command {
 				fmt.Printf("%s Finished %s\n", ui.Muted("───"), ui.Muted("───"))
 				for _, m := range finishedMilestones {
 					// Get entry count for this milestone
-					entries, _ := db.GetEntriesByMilestone(m.ProjectName, m.Name)
+					entries, _ := db.GetEntriesByMilestoneID(m.ID)
 					entryCount := len(entries)
 
 					if listAll {
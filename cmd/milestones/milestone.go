@@ -11,6 +11,9 @@ func MilestoneCmds() *cobra.Command {
 
 	cmd.AddCommand(StartCmd())
 	cmd.AddCommand(FinishCmd())
+	cmd.AddCommand(ReopenCmd())
+	cmd.AddCommand(SwitchCmd())
+	cmd.AddCommand(RenameCmd())
 	cmd.AddCommand(StatusCmd())
 	cmd.AddCommand(ListCmd())
 
@@ -0,0 +1,101 @@
+package milestones
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func RenameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rename [old-name] [new-name]",
+		Short: "Rename a milestone",
+		Long:  `Rename a milestone for the current project. Time entries tagged with the milestone keep their association. With a single argument, the active milestone is renamed.`,
+		Args:  cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			projectName, err := project.DetectConfiguredProject()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+				os.Exit(1)
+			}
+
+			var milestone *storage.Milestone
+			newName := strings.TrimSpace(args[len(args)-1])
+
+			if len(args) == 2 {
+				milestone, err = db.GetMilestoneByName(projectName, args[0])
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if milestone == nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("Milestone '%s' not found for %s", args[0], projectName))
+					ui.PrintMuted(0, "Use 'tmpo milestone list' to see available milestones.")
+					ui.NewlineBelow()
+					os.Exit(1)
+				}
+			} else {
+				milestone, err = db.GetActiveMilestoneForProject(projectName)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if milestone == nil {
+					ui.PrintError(ui.EmojiError, "No active milestone found")
+					ui.PrintMuted(0, "Use 'tmpo milestone rename [old-name] [new-name]' to rename a finished milestone.")
+					ui.NewlineBelow()
+					os.Exit(1)
+				}
+			}
+
+			if newName == "" {
+				ui.PrintError(ui.EmojiError, "milestone name cannot be empty")
+				os.Exit(1)
+			}
+
+			if newName == milestone.Name {
+				ui.PrintWarning(ui.EmojiWarning, "No changes detected")
+				ui.NewlineBelow()
+				return
+			}
+
+			existingMilestone, err := db.GetMilestoneByName(projectName, newName)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if existingMilestone != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("Milestone '%s' already exists for %s", newName, projectName))
+				ui.NewlineBelow()
+				os.Exit(1)
+			}
+
+			if err := db.RenameMilestone(milestone.ID, newName); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiMilestone, fmt.Sprintf("Renamed milestone %s → %s", ui.Muted(milestone.Name), ui.Bold(newName)))
+			ui.NewlineBelow()
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,69 @@
+package milestones
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func ReopenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reopen [name]",
+		Short: "Reopen a finished milestone",
+		Long:  `Reopen a finished milestone for the current project. The reopened milestone becomes the active milestone and new time entries will be tagged with it again.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			projectName, err := project.DetectConfiguredProject()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+				os.Exit(1)
+			}
+
+			milestoneName := args[0]
+
+			milestone, err := db.GetMilestoneByName(projectName, milestoneName)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if milestone == nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("Milestone '%s' not found for %s", milestoneName, projectName))
+				ui.PrintMuted(0, "Use 'tmpo milestone list' to see available milestones.")
+				ui.NewlineBelow()
+				os.Exit(1)
+			}
+
+			if milestone.IsActive() {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("Milestone '%s' has not been finished", milestone.Name))
+				ui.PrintMuted(0, fmt.Sprintf("Use 'tmpo milestone switch \"%s\"' to make it the active milestone.", milestone.Name))
+				ui.NewlineBelow()
+				os.Exit(1)
+			}
+
+			if err := db.ReopenMilestone(milestone.ID); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiMilestone, fmt.Sprintf("Reopened milestone %s for %s", ui.Bold(milestone.Name), ui.Bold(projectName)))
+			ui.PrintMuted(4, "└─ New time entries will be automatically tagged")
+			ui.NewlineBelow()
+		},
+	}
+
+	return cmd
+}
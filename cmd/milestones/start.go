@@ -43,7 +43,7 @@ func StartCmd() *cobra.Command {
 
 			if activeMilestone != nil {
 				ui.PrintError(ui.EmojiError, fmt.Sprintf("Milestone '%s' is already active for %s", activeMilestone.Name, projectName))
-				ui.PrintMuted(0, "Use 'tmpo milestone finish' to finish it first.")
+				ui.PrintMuted(0, "Use 'tmpo milestone finish' to finish it first, or 'tmpo milestone switch' to keep it open.")
 				ui.NewlineBelow()
 				os.Exit(1)
 			}
@@ -48,7 +48,7 @@ func StatusCmd() *cobra.Command {
 			}
 
 			// Get entries for this milestone
-			entries, err := db.GetEntriesByMilestone(projectName, activeMilestone.Name)
+			entries, err := db.GetEntriesByMilestoneID(activeMilestone.ID)
 			if err != nil {
 				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
 				os.Exit(1)
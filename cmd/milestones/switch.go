@@ -0,0 +1,81 @@
+package milestones
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func SwitchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "switch [name]",
+		Short: "Switch the active milestone",
+		Long:  `Make another milestone the active one for the current project without finishing the current milestone. If the milestone does not exist yet it is started.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			projectName, err := project.DetectConfiguredProject()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+				os.Exit(1)
+			}
+
+			milestoneName := args[0]
+
+			activeMilestone, err := db.GetActiveMilestoneForProject(projectName)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if activeMilestone != nil && activeMilestone.Name == milestoneName {
+				ui.PrintWarning(ui.EmojiWarning, fmt.Sprintf("Milestone '%s' is already active", milestoneName))
+				ui.NewlineBelow()
+				return
+			}
+
+			target, err := db.GetMilestoneByName(projectName, milestoneName)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if target == nil {
+				target, err = db.CreateMilestone(projectName, milestoneName)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("failed to create milestone: %v", err))
+					os.Exit(1)
+				}
+			} else if !target.IsActive() {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("Milestone '%s' has already been finished", target.Name))
+				ui.PrintMuted(0, fmt.Sprintf("Use 'tmpo milestone reopen \"%s\"' to reopen it.", target.Name))
+				ui.NewlineBelow()
+				os.Exit(1)
+			} else if err := db.SwitchMilestone(target.ID); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiMilestone, fmt.Sprintf("Switched to milestone %s for %s", ui.Bold(target.Name), ui.Bold(projectName)))
+			if activeMilestone != nil {
+				ui.PrintInfo(4, "Previous", fmt.Sprintf("%s (still open)", activeMilestone.Name))
+			}
+			ui.PrintMuted(4, "└─ New time entries will be automatically tagged")
+			ui.NewlineBelow()
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,120 @@
+package projects
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+)
+
+// fileSnapshot remembers the original contents of config files so a failed
+// rename can put them back.
+type fileSnapshot map[string][]byte
+
+func (s fileSnapshot) save(path string) error {
+	if _, ok := s[path]; ok {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		s[path] = nil
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s[path] = data
+	return nil
+}
+
+func (s fileSnapshot) restore() {
+	for path, data := range s {
+		if data == nil {
+			os.Remove(path)
+			continue
+		}
+		os.WriteFile(path, data, 0644)
+	}
+}
+
+// moveProject moves everything recorded under source to target: entries and
+// milestones in the database, the global registry and config, and the .tmporc
+// files that apply to the current directory. The database changes are only
+// committed once every file has been written; if anything fails, the files
+// are restored and the transaction is rolled back.
+func moveProject(db *storage.Database, source, target string) (*storage.ProjectMergeResult, []string, error) {
+	var updated []string
+	snapshot := fileSnapshot{}
+
+	updateFiles := func() error {
+		configFiles, err := settings.FindConfigChain()
+		if err != nil {
+			return err
+		}
+
+		for _, configFile := range configFiles {
+			if err := snapshot.save(configFile); err != nil {
+				return err
+			}
+
+			changed, err := settings.RenameProjectInConfig(configFile, source, target)
+			if err != nil {
+				return err
+			}
+			if changed {
+				updated = append(updated, configFile)
+			}
+		}
+
+		registry, err := settings.LoadProjects()
+		if err != nil {
+			return fmt.Errorf("failed to load projects registry: %w", err)
+		}
+
+		if registry.MergeProject(source, target) {
+			registryPath, err := settings.GetProjectsPath()
+			if err != nil {
+				return err
+			}
+			if err := snapshot.save(registryPath); err != nil {
+				return err
+			}
+			if err := registry.Save(); err != nil {
+				return err
+			}
+			updated = append(updated, registryPath)
+		}
+
+		globalCfg, err := settings.LoadGlobalConfig()
+		if err != nil {
+			return err
+		}
+
+		if globalCfg.RenameProject(source, target) {
+			configPath, err := settings.GetGlobalConfigPath()
+			if err != nil {
+				return err
+			}
+			if err := snapshot.save(configPath); err != nil {
+				return err
+			}
+			if err := globalCfg.Save(); err != nil {
+				return err
+			}
+			updated = append(updated, configPath)
+		}
+
+		return nil
+	}
+
+	result, err := db.MergeProjects(source, target, updateFiles)
+	if err != nil {
+		snapshot.restore()
+		return nil, nil, err
+	}
+
+	return result, updated, nil
+}
@@ -0,0 +1,108 @@
+package projects
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func ArchiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive [name]",
+		Short: "Archive a project",
+		Long: `Archive a finished project. Archived projects keep all their data but are hidden from
+the project pickers of 'tmpo edit' and 'tmpo delete' and from 'tmpo stats' (unless
+--include-archived is used). Use 'tmpo project unarchive' to restore it.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			setArchived(strings.TrimSpace(args[0]), true)
+		},
+	}
+
+	return cmd
+}
+
+func UnarchiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unarchive [name]",
+		Short: "Restore an archived project",
+		Long:  `Restore an archived project so it shows up in pickers and stats again.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			setArchived(strings.TrimSpace(args[0]), false)
+		},
+	}
+
+	return cmd
+}
+
+func setArchived(projectName string, archive bool) {
+	ui.NewlineAbove()
+
+	db, err := storage.Initialize()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	summaries, err := collectProjects(db)
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	summary := findProject(summaries, projectName)
+	if summary == nil || (summary.Entries == 0 && summary.Milestones == 0) {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("Project '%s' has no tracked time to archive", projectName))
+		ui.NewlineBelow()
+		os.Exit(1)
+	}
+
+	if summary.Archived == archive {
+		state := "not archived"
+		if archive {
+			state = "already archived"
+		}
+		ui.PrintWarning(ui.EmojiWarning, fmt.Sprintf("Project %s is %s", ui.Bold(projectName), state))
+		ui.NewlineBelow()
+		return
+	}
+
+	if !archive {
+		if err := db.UnarchiveProject(projectName); err != nil {
+			ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+			os.Exit(1)
+		}
+
+		ui.PrintSuccess(ui.EmojiProject, fmt.Sprintf("Unarchived project %s", ui.Bold(projectName)))
+		ui.NewlineBelow()
+		return
+	}
+
+	running, err := db.GetRunningEntry()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	if running != nil && running.ProjectName == projectName {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("Currently tracking time for `%s`", projectName))
+		ui.PrintMuted(0, "Use 'tmpo stop' before archiving the project.")
+		ui.NewlineBelow()
+		os.Exit(1)
+	}
+
+	if err := db.ArchiveProject(projectName); err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	ui.PrintSuccess(ui.EmojiProject, fmt.Sprintf("Archived project %s", ui.Bold(projectName)))
+	ui.PrintMuted(4, "└─ Hidden from pickers and stats; use 'tmpo project unarchive' to restore it.")
+	ui.NewlineBelow()
+}
@@ -0,0 +1,148 @@
+package projects
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var deleteYes bool
+
+func DeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [name]",
+		Short: "Delete a project and all of its data",
+		Long: `Permanently delete a project's time entries, milestones and global configuration.
+Without a name, pick from the non-archived projects. Local .tmporc files are left in place.`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			summaries, err := collectProjects(db)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			var projectName string
+			if len(args) > 0 {
+				projectName = strings.TrimSpace(args[0])
+			} else {
+				projectName, err = selectProject(summaries, "Select project to delete", nil)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+			}
+
+			summary := findProject(summaries, projectName)
+			if summary == nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("Project '%s' not found", projectName))
+				ui.NewlineBelow()
+				os.Exit(1)
+			}
+
+			running, err := db.GetRunningEntry()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if running != nil && running.ProjectName == projectName {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("Currently tracking time for `%s`", projectName))
+				ui.PrintMuted(0, "Use 'tmpo stop' before deleting the project.")
+				ui.NewlineBelow()
+				os.Exit(1)
+			}
+
+			fmt.Println()
+			ui.PrintWarning(ui.EmojiWarning, "You are about to permanently delete this project:")
+			fmt.Println()
+			ui.PrintInfo(4, ui.Bold("Project"), summary.Name)
+			ui.PrintInfo(4, ui.Bold("Entries"), fmt.Sprintf("%d (%s)", summary.Entries, ui.FormatDuration(summary.Duration)))
+			ui.PrintInfo(4, ui.Bold("Milestones"), fmt.Sprintf("%d", summary.Milestones))
+			if summary.Global {
+				ui.PrintInfo(4, ui.Bold("Global Project"), "will be removed from the registry")
+			}
+			fmt.Println()
+
+			if !deleteYes {
+				confirmPrompt := promptui.Select{
+					Label: "Are you sure you want to delete this project?",
+					Items: []string{"No", "Yes"},
+				}
+
+				_, result, err := confirmPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if result == "No" {
+					ui.PrintWarning(ui.EmojiWarning, "Deletion cancelled")
+					ui.NewlineBelow()
+					return
+				}
+			}
+
+			var registry *settings.ProjectsRegistry
+			if summary.Global {
+				registry, err = settings.LoadProjects()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("failed to load projects registry: %v", err))
+					os.Exit(1)
+				}
+			}
+
+			// the registry is only updated once the entries are gone, so a failed
+			// transaction leaves the project intact
+			result, err := db.DeleteProject(summary.Name)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if registry != nil {
+				if err := registry.DeleteProject(summary.Name); err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if err := registry.Save(); err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("failed to save projects registry: %v", err))
+					os.Exit(1)
+				}
+			}
+
+			fmt.Println()
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Deleted project %s", ui.Bold(summary.Name)))
+			ui.PrintInfo(4, ui.Bold("Entries"), fmt.Sprintf("%d", result.EntriesDeleted))
+			ui.PrintInfo(4, ui.Bold("Milestones"), fmt.Sprintf("%d", result.MilestonesDeleted))
+
+			if result.ExpensesDeleted > 0 {
+				ui.PrintInfo(4, ui.Bold("Expenses"), fmt.Sprintf("%d", result.ExpensesDeleted))
+			}
+			if result.TopUpsDeleted > 0 {
+				ui.PrintInfo(4, ui.Bold("Retainer Top-ups"), fmt.Sprintf("%d", result.TopUpsDeleted))
+			}
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().BoolVarP(&deleteYes, "yes", "y", false, "Delete without asking for confirmation")
+
+	return cmd
+}
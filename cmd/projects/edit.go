@@ -0,0 +1,289 @@
+package projects
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/DylanDevelops/tmpo/internal/currency"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+func EditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit [name]",
+		Short: "Edit a global project",
+		Long: `Edit the hourly rate, currency, tax rate, discount, description, export path, goals and paths of a global project
+using an interactive form. Without a name, pick from the non-archived global projects.
+Local projects are configured in their .tmporc file.`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			registry, err := settings.LoadProjects()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("failed to load projects registry: %v", err))
+				os.Exit(1)
+			}
+
+			var projectName string
+			if len(args) > 0 {
+				projectName = strings.TrimSpace(args[0])
+			} else {
+				db, err := storage.Initialize()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				summaries, err := collectProjects(db)
+				db.Close()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				projectName, err = selectProject(summaries, "Select project to edit", func(summary *projectSummary) bool {
+					return summary.Global
+				})
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+			}
+
+			globalProject, err := registry.GetProject(projectName)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				ui.PrintMuted(0, "Only global projects can be edited here; local projects are configured in .tmporc.")
+				ui.NewlineBelow()
+				os.Exit(1)
+			}
+
+			updated := *globalProject
+
+			fmt.Println()
+			ui.PrintInfo(0, ui.Bold("Editing project"), globalProject.Name)
+			fmt.Println()
+
+			updated.HourlyRate = promptOptionalFloat("Hourly rate (leave empty to clear)", globalProject.HourlyRate)
+			updated.Currency = promptCurrency(globalProject.Currency)
+			updated.TaxRate = promptPercentage("Tax rate % (leave empty for the global tax rate)", globalProject.TaxRate)
+			updated.Discount = promptPercentage("Discount % (leave empty for none)", globalProject.Discount)
+			if updated.Discount != nil && *updated.Discount == 0 {
+				updated.Discount = nil
+			}
+			updated.Billable = promptBillable(globalProject.Billable)
+			updated.Description = promptText("Description", globalProject.Description)
+			updated.ExportPath = promptText("Export path", globalProject.ExportPath)
+			updated.DailyGoalHours = promptOptionalFloat("Daily goal hours (leave empty to clear)", globalProject.DailyGoalHours)
+			updated.WeeklyGoalHours = promptOptionalFloat("Weekly goal hours (leave empty to clear)", globalProject.WeeklyGoalHours)
+			updated.Paths = settings.ParseProjectPaths(promptText("Project paths, comma-separated", strings.Join(globalProject.Paths, ", ")))
+
+			if err := registry.UpdateProject(globalProject.Name, updated); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if err := registry.Save(); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("failed to save projects registry: %v", err))
+				os.Exit(1)
+			}
+
+			fmt.Println()
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Updated project %s", ui.Bold(updated.Name)))
+			printGlobalProject(&updated)
+			ui.NewlineBelow()
+		},
+	}
+
+	return cmd
+}
+
+func promptText(label, current string) string {
+	prompt := promptui.Prompt{
+		Label:     label,
+		Default:   current,
+		AllowEdit: true,
+	}
+
+	input, err := prompt.Run()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	return strings.TrimSpace(input)
+}
+
+func promptOptionalFloat(label string, current *float64) *float64 {
+	defaultValue := ""
+	if current != nil {
+		defaultValue = strconv.FormatFloat(*current, 'f', -1, 64)
+	}
+
+	prompt := promptui.Prompt{
+		Label:     label,
+		Default:   defaultValue,
+		AllowEdit: true,
+		Validate: func(input string) error {
+			_, err := parseOptionalFloat(input)
+			return err
+		},
+	}
+
+	input, err := prompt.Run()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	value, _ := parseOptionalFloat(input)
+	return value
+}
+
+// parseOptionalFloat parses a non-negative number, treating empty input and
+// zero as unset.
+func parseOptionalFloat(input string) (*float64, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseFloat(input, 64)
+	if err != nil {
+		return nil, fmt.Errorf("must be a valid number")
+	}
+
+	if value < 0 {
+		return nil, fmt.Errorf("cannot be negative")
+	}
+
+	if value == 0 {
+		return nil, nil
+	}
+
+	return &value, nil
+}
+
+// promptPercentage asks for a percentage such as a tax rate or discount.
+// Empty input leaves it unset; 0 is kept so a project can opt out of the
+// global tax rate.
+func promptPercentage(label string, current *float64) *float64 {
+	defaultValue := ""
+	if current != nil {
+		defaultValue = strconv.FormatFloat(*current, 'f', -1, 64)
+	}
+
+	prompt := promptui.Prompt{
+		Label:     label,
+		Default:   defaultValue,
+		AllowEdit: true,
+		Validate: func(input string) error {
+			_, err := parsePercentage(input)
+			return err
+		},
+	}
+
+	input, err := prompt.Run()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	value, _ := parsePercentage(input)
+	return value
+}
+
+// parsePercentage parses a percentage between 0 and 100, treating empty
+// input as unset.
+func parsePercentage(input string) (*float64, error) {
+	input = strings.TrimSuffix(strings.TrimSpace(input), "%")
+	if input == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.ParseFloat(input, 64)
+	if err != nil {
+		return nil, fmt.Errorf("must be a valid number")
+	}
+
+	if value < 0 || value > 100 {
+		return nil, fmt.Errorf("must be between 0 and 100")
+	}
+
+	return &value, nil
+}
+
+// promptCurrency asks for the currency the project is billed in. Empty input
+// keeps using the global currency.
+func promptCurrency(current string) string {
+	prompt := promptui.Prompt{
+		Label:     "Currency code (leave empty for the global currency)",
+		Default:   current,
+		AllowEdit: true,
+		Validate: func(input string) error {
+			input = strings.TrimSpace(input)
+			if input == "" {
+				return nil
+			}
+
+			if len(input) != 3 {
+				return fmt.Errorf("currency code must be 3 letters (e.g., USD, EUR, GBP)")
+			}
+
+			for _, char := range strings.ToUpper(input) {
+				if char < 'A' || char > 'Z' {
+					return fmt.Errorf("currency code must contain only letters")
+				}
+			}
+
+			if !currency.IsSupported(input) {
+				return fmt.Errorf("unknown ISO 4217 currency code: %s", strings.ToUpper(input))
+			}
+
+			return nil
+		},
+	}
+
+	input, err := prompt.Run()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	return strings.ToUpper(strings.TrimSpace(input))
+}
+
+// promptBillable asks whether new entries of the project are billable. Since
+// projects are billable unless configured otherwise, only "No" is stored.
+func promptBillable(current *bool) *bool {
+	cursor := 0
+	if current != nil && !*current {
+		cursor = 1
+	}
+
+	prompt := promptui.Select{
+		Label:     "Billable by default?",
+		Items:     []string{"Yes", "No"},
+		CursorPos: cursor,
+	}
+
+	_, result, err := prompt.Run()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	if result == "Yes" {
+		return nil
+	}
+
+	billable := false
+	return &billable
+}
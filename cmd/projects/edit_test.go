@@ -0,0 +1,77 @@
+package projects
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOptionalFloat(t *testing.T) {
+	value, err := parseOptionalFloat(" 150.5 ")
+	require.NoError(t, err)
+	require.NotNil(t, value)
+	assert.Equal(t, 150.5, *value)
+
+	value, err = parseOptionalFloat("")
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = parseOptionalFloat("0")
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	_, err = parseOptionalFloat("-1")
+	assert.Error(t, err)
+
+	_, err = parseOptionalFloat("abc")
+	assert.Error(t, err)
+}
+
+func TestParsePercentage(t *testing.T) {
+	value, err := parsePercentage(" 19% ")
+	require.NoError(t, err)
+	require.NotNil(t, value)
+	assert.Equal(t, 19.0, *value)
+
+	value, err = parsePercentage("0")
+	require.NoError(t, err)
+	require.NotNil(t, value)
+	assert.Equal(t, 0.0, *value)
+
+	value, err = parsePercentage("")
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	_, err = parsePercentage("101")
+	assert.Error(t, err)
+
+	_, err = parsePercentage("-5")
+	assert.Error(t, err)
+}
+
+func TestFileSnapshotRestore(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, ".tmporc")
+	created := filepath.Join(dir, "projects.yaml")
+
+	require.NoError(t, os.WriteFile(existing, []byte("project_name: api\n"), 0644))
+
+	snapshot := fileSnapshot{}
+	require.NoError(t, snapshot.save(existing))
+	require.NoError(t, snapshot.save(created))
+
+	require.NoError(t, os.WriteFile(existing, []byte("project_name: api-server\n"), 0644))
+	require.NoError(t, os.WriteFile(created, []byte("projects: []\n"), 0644))
+
+	snapshot.restore()
+
+	data, err := os.ReadFile(existing)
+	require.NoError(t, err)
+	assert.Equal(t, "project_name: api\n", string(data))
+
+	_, err = os.Stat(created)
+	assert.True(t, os.IsNotExist(err))
+}
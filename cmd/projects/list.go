@@ -0,0 +1,89 @@
+package projects
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var listArchived bool
+
+func ListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List projects",
+		Long:  `List every project with entries, milestones or a global configuration. Archived projects are only shown with --archived.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			summaries, err := collectProjects(db)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			var shown []*projectSummary
+			hidden := 0
+			for _, summary := range summaries {
+				if summary.Archived && !listArchived {
+					hidden++
+					continue
+				}
+				shown = append(shown, summary)
+			}
+
+			if len(shown) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, "No projects found")
+				ui.NewlineBelow()
+				return
+			}
+
+			ui.PrintSuccess(ui.EmojiProject, "Projects")
+			fmt.Println()
+
+			for _, summary := range shown {
+				name := ui.Bold(summary.Name)
+				if summary.Global {
+					name += " " + ui.Muted("(global)")
+				}
+				if summary.Archived {
+					name += " " + ui.Warning("(archived)")
+				}
+				fmt.Printf("  %s\n", name)
+
+				details := fmt.Sprintf("    %s  Entries: %d", ui.FormatDuration(summary.Duration), summary.Entries)
+				if summary.HasEarnings {
+					details += fmt.Sprintf("  Earnings: %s", settings.FormatCurrency(summary.Earnings, project.GetProjectCurrency(summary.Name)))
+				}
+				if summary.LastActivity != nil {
+					details += fmt.Sprintf("  Last: %s", settings.FormatDate(*summary.LastActivity))
+				}
+				fmt.Println(details)
+			}
+
+			if hidden > 0 {
+				fmt.Println()
+				ui.PrintMuted(0, fmt.Sprintf("%d archived project(s) hidden. Use --archived to show them.", hidden))
+			}
+
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().BoolVar(&listArchived, "archived", false, "Include archived projects")
+
+	return cmd
+}
@@ -0,0 +1,182 @@
+package projects
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var mergeYes bool
+
+func MergeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge [source] [target]",
+		Short: "Merge one project into another",
+		Long: `Move every entry and milestone of the source project into the target project, then
+remove the source from the global projects registry and point .tmporc files that apply to
+the current directory at the target. Milestones with the same name in both projects are
+combined. Registry settings the target leaves unset are taken from the source, and the
+source's rate schedule moves unless the target has its own. All changes are applied
+together or not at all.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			source := strings.TrimSpace(args[0])
+			target := strings.TrimSpace(args[1])
+
+			if source == "" || target == "" {
+				ui.PrintError(ui.EmojiError, "project name cannot be empty")
+				os.Exit(1)
+			}
+
+			if source == target {
+				ui.PrintError(ui.EmojiError, "cannot merge a project into itself")
+				os.Exit(1)
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			for _, name := range []string{source, target} {
+				exists, err := projectExists(db, name)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if !exists {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("Project '%s' not found", name))
+					ui.NewlineBelow()
+					os.Exit(1)
+				}
+			}
+
+			entries, err := db.GetEntriesByProject(source)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			collisions, err := milestoneCollisions(db, source, target)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			droppedRates, err := ratesToDrop(db, source, target)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			var copiedSettings, droppedSettings []string
+			if registry, err := settings.LoadProjects(); err == nil {
+				copiedSettings, droppedSettings = registry.MergeSettingsPreview(source, target)
+			}
+
+			ui.PrintWarning(ui.EmojiWarning, fmt.Sprintf("You are about to merge %s into %s:", ui.Bold(source), ui.Bold(target)))
+			fmt.Println()
+			ui.PrintInfo(4, ui.Bold("Entries"), fmt.Sprintf("%d", len(entries)))
+			if len(collisions) > 0 {
+				ui.PrintInfo(4, ui.Bold("Milestones to Combine"), strings.Join(collisions, ", "))
+			}
+			if len(copiedSettings) > 0 {
+				ui.PrintInfo(4, ui.Bold("Settings to Copy"), strings.Join(copiedSettings, ", "))
+			}
+			if len(droppedSettings) > 0 {
+				ui.PrintInfo(4, ui.Bold("Settings to Drop"), fmt.Sprintf("%s (%s keeps its own)", strings.Join(droppedSettings, ", "), target))
+			}
+			if droppedRates > 0 {
+				ui.PrintInfo(4, ui.Bold("Rates to Drop"), fmt.Sprintf("%d (%s keeps its own schedule)", droppedRates, target))
+			}
+			fmt.Println()
+
+			if !mergeYes {
+				confirmPrompt := promptui.Select{
+					Label: fmt.Sprintf("Merge '%s' into '%s'?", source, target),
+					Items: []string{"No", "Yes"},
+				}
+
+				_, result, err := confirmPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if result == "No" {
+					ui.PrintWarning(ui.EmojiWarning, "Merge cancelled")
+					ui.NewlineBelow()
+					return
+				}
+			}
+
+			result, updatedFiles, err := moveProject(db, source, target)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("merging projects: %v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiProject, fmt.Sprintf("Merged %s into %s", ui.Muted(source), ui.Bold(target)))
+			printMoveResult(result, updatedFiles)
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().BoolVarP(&mergeYes, "yes", "y", false, "Merge without asking for confirmation")
+
+	return cmd
+}
+
+// ratesToDrop returns how many scheduled rates of source a merge drops because
+// target has a rate schedule of its own.
+func ratesToDrop(db *storage.Database, source, target string) (int, error) {
+	targetRates, err := db.GetRates(target)
+	if err != nil || len(targetRates) == 0 {
+		return 0, err
+	}
+
+	sourceRates, err := db.GetRates(source)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(sourceRates), nil
+}
+
+// milestoneCollisions returns the milestone names used by both projects.
+func milestoneCollisions(db *storage.Database, source, target string) ([]string, error) {
+	sourceMilestones, err := db.GetMilestonesByProject(source)
+	if err != nil {
+		return nil, err
+	}
+
+	targetMilestones, err := db.GetMilestonesByProject(target)
+	if err != nil {
+		return nil, err
+	}
+
+	targetNames := make(map[string]bool, len(targetMilestones))
+	for _, milestone := range targetMilestones {
+		targetNames[milestone.Name] = true
+	}
+
+	var collisions []string
+	for _, milestone := range sourceMilestones {
+		if targetNames[milestone.Name] {
+			collisions = append(collisions, milestone.Name)
+		}
+	}
+
+	return collisions, nil
+}
@@ -0,0 +1,22 @@
+package projects
+
+import "github.com/spf13/cobra"
+
+func ProjectCmds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project",
+		Short: "Manage projects",
+		Long:  `Manage projects across the database, the global projects registry and local .tmporc files.`,
+	}
+
+	cmd.AddCommand(ListCmd())
+	cmd.AddCommand(ShowCmd())
+	cmd.AddCommand(EditCmd())
+	cmd.AddCommand(ArchiveCmd())
+	cmd.AddCommand(UnarchiveCmd())
+	cmd.AddCommand(DeleteCmd())
+	cmd.AddCommand(RenameCmd())
+	cmd.AddCommand(MergeCmd())
+
+	return cmd
+}
@@ -0,0 +1,136 @@
+package projects
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func RenameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rename [old-name] [new-name]",
+		Short: "Rename a project",
+		Long: `Rename a project everywhere it is recorded: time entries, milestones, the global
+projects registry and config, and the .tmporc files that apply to the current directory.
+All changes are applied together or not at all.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			oldName := strings.TrimSpace(args[0])
+			newName := strings.TrimSpace(args[1])
+
+			if oldName == "" || newName == "" {
+				ui.PrintError(ui.EmojiError, "project name cannot be empty")
+				os.Exit(1)
+			}
+
+			if oldName == newName {
+				ui.PrintWarning(ui.EmojiWarning, "No changes detected")
+				ui.NewlineBelow()
+				return
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			oldExists, err := projectExists(db, oldName)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if !oldExists {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("Project '%s' not found", oldName))
+				ui.NewlineBelow()
+				os.Exit(1)
+			}
+
+			// a case-only rename refers to the same registry project
+			if !strings.EqualFold(oldName, newName) {
+				newExists, err := projectExists(db, newName)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if newExists {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("Project '%s' already exists", newName))
+					ui.PrintMuted(0, fmt.Sprintf("Use 'tmpo project merge \"%s\" \"%s\"' to combine them.", oldName, newName))
+					ui.NewlineBelow()
+					os.Exit(1)
+				}
+			}
+
+			result, updatedFiles, err := moveProject(db, oldName, newName)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("renaming project: %v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiProject, fmt.Sprintf("Renamed project %s → %s", ui.Muted(oldName), ui.Bold(newName)))
+			printMoveResult(result, updatedFiles)
+			ui.NewlineBelow()
+		},
+	}
+
+	return cmd
+}
+
+// projectExists reports whether name is used by any entry or milestone or is
+// registered as a global project.
+func projectExists(db *storage.Database, name string) (bool, error) {
+	exists, err := db.ProjectExists(name)
+	if err != nil || exists {
+		return exists, err
+	}
+
+	registry, err := settings.LoadProjects()
+	if err != nil {
+		return false, fmt.Errorf("failed to load projects registry: %w", err)
+	}
+
+	return registry.Exists(name), nil
+}
+
+func printMoveResult(result *storage.ProjectMergeResult, updatedFiles []string) {
+	ui.PrintInfo(4, ui.Bold("Entries"), fmt.Sprintf("%d", result.EntriesMoved))
+	ui.PrintInfo(4, ui.Bold("Milestones"), fmt.Sprintf("%d", result.MilestonesMoved+int64(len(result.MilestonesMerged))))
+
+	if result.ExpensesMoved > 0 {
+		ui.PrintInfo(4, ui.Bold("Expenses"), fmt.Sprintf("%d", result.ExpensesMoved))
+	}
+
+	if result.TopUpsMoved > 0 {
+		ui.PrintInfo(4, ui.Bold("Retainer Top-ups"), fmt.Sprintf("%d", result.TopUpsMoved))
+	}
+
+	if result.RatesMoved > 0 {
+		ui.PrintInfo(4, ui.Bold("Rates"), fmt.Sprintf("%d", result.RatesMoved))
+	}
+
+	if result.RatesDropped > 0 {
+		ui.PrintInfo(4, ui.Bold("Dropped Rates"), fmt.Sprintf("%d", result.RatesDropped))
+	}
+
+	if len(result.MilestonesMerged) > 0 {
+		ui.PrintInfo(4, ui.Bold("Merged Milestones"), strings.Join(result.MilestonesMerged, ", "))
+	}
+
+	for i, updatedFile := range updatedFiles {
+		prefix := "├─"
+		if i == len(updatedFiles)-1 {
+			prefix = "└─"
+		}
+		ui.PrintMuted(4, fmt.Sprintf("%s Updated %s", prefix, updatedFile))
+	}
+}
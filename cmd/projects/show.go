@@ -0,0 +1,175 @@
+package projects
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func ShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [name]",
+		Short: "Show a project summary",
+		Long:  `Show lifetime hours, earnings, milestones and configuration for a project. Defaults to the current project.`,
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			var projectName string
+			if len(args) > 0 {
+				projectName = strings.TrimSpace(args[0])
+			} else {
+				projectName, err = project.DetectConfiguredProject()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+					os.Exit(1)
+				}
+			}
+
+			summaries, err := collectProjects(db)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			summary := findProject(summaries, projectName)
+			if summary == nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("Project '%s' not found", projectName))
+				ui.PrintMuted(0, "Use 'tmpo project list' to see available projects.")
+				ui.NewlineBelow()
+				os.Exit(1)
+			}
+
+			projectSettings := project.LoadProjectSettings(summary.Name)
+			currencyCode := projectSettings.CurrencyCode()
+
+			title := fmt.Sprintf("Project %s", ui.Bold(summary.Name))
+			if summary.Archived {
+				title += " " + ui.Warning("(archived)")
+			}
+			ui.PrintSuccess(ui.EmojiProject, title)
+			fmt.Println()
+
+			if registry, err := settings.LoadProjects(); err == nil && summary.Global {
+				if globalProject, err := registry.GetProject(summary.Name); err == nil {
+					printGlobalProject(globalProject)
+					fmt.Println()
+				}
+			}
+
+			ui.PrintInfo(4, ui.Bold("Total Time"), fmt.Sprintf("%s (%.2f hours)", ui.FormatDuration(summary.Duration), summary.Duration.Hours()))
+			ui.PrintInfo(4, ui.Bold("Total Entries"), fmt.Sprintf("%d", summary.Entries))
+			if summary.Billable != summary.Duration {
+				ui.PrintInfo(4, ui.Bold("Billable Time"), fmt.Sprintf("%s (%.2f hours)", ui.FormatDuration(summary.Billable), summary.Billable.Hours()))
+			}
+			if summary.HasEarnings {
+				format := settings.GetCurrencyFormat()
+				ui.PrintInfo(4, ui.Bold("Earnings"), format.FormatCurrency(summary.Earnings, currencyCode))
+				if terms := projectSettings.Terms(); !terms.IsZero() {
+					amounts := terms.Apply(summary.Earnings)
+					ui.PrintMuted(4, fmt.Sprintf("└─ %s: net %s, tax %s, gross %s", terms,
+						format.FormatCurrency(amounts.Net, currencyCode),
+						format.FormatCurrency(amounts.Tax, currencyCode),
+						format.FormatCurrency(amounts.Gross, currencyCode)))
+				}
+			}
+
+			entries, err := db.GetEntriesByProject(summary.Name)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if len(entries) > 0 {
+				// entries are ordered newest first
+				ui.PrintInfo(4, ui.Bold("First Entry"), settings.FormatDateLong(entries[len(entries)-1].StartTime))
+				ui.PrintInfo(4, ui.Bold("Last Entry"), settings.FormatDateLong(entries[0].StartTime))
+			}
+
+			milestones, err := db.GetMilestonesByProject(summary.Name)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if len(milestones) > 0 {
+				fmt.Println()
+				ui.PrintInfo(4, ui.Bold("Milestones"), "")
+
+				for _, milestone := range milestones {
+					milestoneEntries, _ := db.GetEntriesByMilestoneID(milestone.ID)
+
+					var tracked time.Duration
+					for _, entry := range milestoneEntries {
+						tracked += entry.Duration()
+					}
+
+					status := ui.Muted("finished")
+					if milestone.IsActive() {
+						status = ui.Success("active")
+					}
+
+					fmt.Printf("        %s  %s  %s  (%d entries, %s)\n",
+						ui.Bold(fmt.Sprintf("%-20s", milestone.Name)),
+						status,
+						settings.FormatDate(milestone.StartTime),
+						len(milestoneEntries),
+						ui.FormatDuration(tracked))
+				}
+			}
+
+			ui.NewlineBelow()
+		},
+	}
+
+	return cmd
+}
+
+func printGlobalProject(globalProject *settings.GlobalProject) {
+	ui.PrintInfo(4, ui.Bold("Source"), "global project")
+
+	if globalProject.HourlyRate != nil {
+		ui.PrintInfo(4, ui.Bold("Hourly Rate"), fmt.Sprintf("%.2f", *globalProject.HourlyRate))
+	}
+	if globalProject.Currency != "" {
+		ui.PrintInfo(4, ui.Bold("Currency"), globalProject.Currency)
+	}
+	if globalProject.TaxRate != nil {
+		ui.PrintInfo(4, ui.Bold("Tax Rate"), fmt.Sprintf("%g%%", *globalProject.TaxRate))
+	}
+	if globalProject.Discount != nil {
+		ui.PrintInfo(4, ui.Bold("Discount"), fmt.Sprintf("%g%%", *globalProject.Discount))
+	}
+	if globalProject.Billable != nil && !*globalProject.Billable {
+		ui.PrintInfo(4, ui.Bold("Billable"), "no")
+	}
+	if globalProject.Description != "" {
+		ui.PrintInfo(4, ui.Bold("Description"), globalProject.Description)
+	}
+	if globalProject.ExportPath != "" {
+		ui.PrintInfo(4, ui.Bold("Export Path"), globalProject.ExportPath)
+	}
+	if globalProject.DailyGoalHours != nil {
+		ui.PrintInfo(4, ui.Bold("Daily Goal"), fmt.Sprintf("%gh", *globalProject.DailyGoalHours))
+	}
+	if globalProject.WeeklyGoalHours != nil {
+		ui.PrintInfo(4, ui.Bold("Weekly Goal"), fmt.Sprintf("%gh", *globalProject.WeeklyGoalHours))
+	}
+	if len(globalProject.Paths) > 0 {
+		ui.PrintInfo(4, ui.Bold("Paths"), strings.Join(globalProject.Paths, ", "))
+	}
+}
@@ -0,0 +1,141 @@
+package projects
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/manifoldco/promptui"
+)
+
+// projectSummary aggregates what tmpo knows about a project across the
+// database and the global registry.
+type projectSummary struct {
+	Name         string
+	Global       bool
+	Archived     bool
+	Entries      int
+	Duration     time.Duration
+	Billable     time.Duration
+	Earnings     float64
+	HasEarnings  bool
+	Milestones   int
+	LastActivity *time.Time
+}
+
+// collectProjects returns a summary of every project that has entries or
+// milestones or is registered globally, sorted by name.
+func collectProjects(db *storage.Database) ([]*projectSummary, error) {
+	summaries := make(map[string]*projectSummary)
+	get := func(name string) *projectSummary {
+		if summaries[name] == nil {
+			summaries[name] = &projectSummary{Name: name}
+		}
+		return summaries[name]
+	}
+
+	entries, err := db.GetEntries(0)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		summary := get(entry.ProjectName)
+		summary.Entries++
+		summary.Duration += entry.Duration()
+
+		if entry.Billable {
+			summary.Billable += entry.Duration()
+		}
+
+		if entry.IsBilled() {
+			summary.Earnings += entry.Earnings()
+			summary.HasEarnings = true
+		}
+
+		lastActivity := entry.StartTime
+		if entry.EndTime != nil {
+			lastActivity = *entry.EndTime
+		}
+		if summary.LastActivity == nil || lastActivity.After(*summary.LastActivity) {
+			summary.LastActivity = &lastActivity
+		}
+	}
+
+	milestones, err := db.GetAllMilestones()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, milestone := range milestones {
+		get(milestone.ProjectName).Milestones++
+	}
+
+	registry, err := settings.LoadProjects()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load projects registry: %w", err)
+	}
+
+	for _, globalProject := range registry.ListProjects() {
+		get(globalProject.Name).Global = true
+	}
+
+	archived, err := db.GetArchivedProjects()
+	if err != nil {
+		return nil, err
+	}
+
+	for name := range archived {
+		if summary, ok := summaries[name]; ok {
+			summary.Archived = true
+		}
+	}
+
+	var result []*projectSummary
+	for _, summary := range summaries {
+		result = append(result, summary)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// findProject returns the summary for name, or nil if tmpo doesn't know it.
+func findProject(summaries []*projectSummary, name string) *projectSummary {
+	for _, summary := range summaries {
+		if summary.Name == name {
+			return summary
+		}
+	}
+
+	return nil
+}
+
+// selectProject asks the user to pick one of the non-archived projects
+// accepted by include (nil accepts all).
+func selectProject(summaries []*projectSummary, label string, include func(*projectSummary) bool) (string, error) {
+	var names []string
+	for _, summary := range summaries {
+		if summary.Archived || (include != nil && !include(summary)) {
+			continue
+		}
+		names = append(names, summary.Name)
+	}
+
+	if len(names) == 0 {
+		return "", fmt.Errorf("no projects found")
+	}
+
+	projectPrompt := promptui.Select{
+		Label: label,
+		Items: names,
+	}
+
+	_, selected, err := projectPrompt.Run()
+	return selected, err
+}
@@ -0,0 +1,161 @@
+package rates
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	applyProject string
+	applyRange   string
+	applyYes     bool
+)
+
+func ApplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Recompute the rates of existing entries",
+		Long: `Recompute the hourly rates of a project's entries started in a range, e.g.
+after scheduling a rate change that should also cover past work.
+
+Each entry gets the rate in effect when it started, following the same rules as
+new entries. Entries no scheduled rate applies to get the project's configured
+hourly_rate, or keep their rate if it has none. The changes are listed for
+review before anything is updated.
+
+  tmpo rate apply --range 2026-01-01..`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			start, end, err := settings.ParseDateRange(applyRange, settings.DateLayoutDashed(), time.Now())
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid range: %v", err))
+				os.Exit(1)
+			}
+
+			projectName, err := project.DetectConfiguredProjectWithOverride(applyProject)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+				os.Exit(1)
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			entries, err := db.FindEntries(storage.EntryFilter{Project: projectName, Start: start, End: end})
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if len(entries) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, fmt.Sprintf("No entries of %s in that range.", projectName))
+				ui.NewlineBelow()
+				return
+			}
+
+			rates, err := db.GetRates(projectName)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			projectSettings := project.LoadProjectSettings(projectName)
+			configRate := projectSettings.HourlyRate
+
+			changes := make(map[int64]*float64)
+			var changed []*storage.TimeEntry
+			for _, entry := range entries {
+				newRate := configRate
+				if rate := storage.MatchRate(rates, entry.MilestoneID, entry.Tags, entry.StartTime); rate != nil {
+					newRate = &rate.HourlyRate
+				}
+
+				if newRate == nil || sameRate(entry.HourlyRate, newRate) {
+					continue
+				}
+
+				changes[entry.ID] = newRate
+				changed = append(changed, entry)
+			}
+
+			if len(changed) == 0 {
+				ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("All %d entries of %s already have the right rate", len(entries), ui.Bold(projectName)))
+				ui.NewlineBelow()
+				return
+			}
+
+			currencyCode := projectSettings.CurrencyCode()
+
+			ui.PrintSuccess(ui.EmojiRate, fmt.Sprintf("Update the rates of %d entries of %s", len(changed), ui.Bold(projectName)))
+			fmt.Println()
+
+			fmt.Printf("  %s\n", ui.Muted(fmt.Sprintf("%-6s %-19s %-14s %-14s %s", "ID", "Start", "Old Rate", "New Rate", "Description")))
+			for _, entry := range changed {
+				fmt.Printf("  %-6s %-19s %-14s %-14s %s\n",
+					fmt.Sprintf("#%d", entry.ID),
+					settings.FormatDateTimeDashed(entry.StartTime),
+					formatRate(entry.HourlyRate, currencyCode),
+					formatRate(changes[entry.ID], currencyCode),
+					entry.Description)
+			}
+			fmt.Println()
+
+			if !applyYes {
+				confirmPrompt := promptui.Select{
+					Label: fmt.Sprintf("Update the rates of these %d entries?", len(changed)),
+					Items: []string{"No", "Yes"},
+				}
+
+				_, result, err := confirmPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if result == "No" {
+					ui.PrintWarning(ui.EmojiWarning, "Update cancelled")
+					ui.NewlineBelow()
+					os.Exit(0)
+				}
+			}
+
+			if err := db.SetEntryRates(changes); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				ui.PrintMuted(0, "No entries were changed.")
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Updated the rates of %d entries", len(changed)))
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().StringVarP(&applyProject, "project", "p", "", "Update the entries of a specific global project")
+	cmd.Flags().StringVarP(&applyRange, "range", "r", "", "Entries started in this range (today, week, last-week, month, last-month, DATE or FROM..TO)")
+	cmd.Flags().BoolVarP(&applyYes, "yes", "y", false, "Update without asking for confirmation")
+	cmd.MarkFlagRequired("range")
+
+	return cmd
+}
+
+func sameRate(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,88 @@
+package rates
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+var deleteYes bool
+
+func DeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a scheduled rate",
+		Long:  `Delete a scheduled rate by its ID, as shown by 'tmpo rate list'. Existing entries keep their rates.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid rate ID '%s'", args[0]))
+				os.Exit(1)
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			rate, err := db.GetRate(id)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			if rate == nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("rate #%d not found", id))
+				os.Exit(1)
+			}
+
+			summary := fmt.Sprintf("%s for %s from %s (%s)",
+				formatRate(&rate.HourlyRate, project.GetProjectCurrency(rate.ProjectName)),
+				rate.ProjectName,
+				settings.FormatDateDashed(rate.EffectiveFrom),
+				rate.Scope())
+
+			if !deleteYes {
+				confirmPrompt := promptui.Select{
+					Label: fmt.Sprintf("Delete rate %s?", summary),
+					Items: []string{"No", "Yes"},
+				}
+
+				_, result, err := confirmPrompt.Run()
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				if result == "No" {
+					ui.PrintWarning(ui.EmojiWarning, "Deletion cancelled")
+					ui.NewlineBelow()
+					os.Exit(0)
+				}
+			}
+
+			if err := db.DeleteRate(rate.ID); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Deleted rate %s", summary))
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().BoolVarP(&deleteYes, "yes", "y", false, "Delete without asking for confirmation")
+
+	return cmd
+}
@@ -0,0 +1,92 @@
+package rates
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	listProject string
+	listAll     bool
+)
+
+func ListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List scheduled rates",
+		Long:  `List the rate schedule of the current project, latest first. Use --all to list the rates of all projects.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			projectName := ""
+			if !listAll {
+				var err error
+				projectName, err = project.DetectConfiguredProjectWithOverride(listProject)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+					os.Exit(1)
+				}
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			rates, err := db.GetRates(projectName)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if len(rates) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, "No rates scheduled")
+				if !listAll {
+					if projectSettings := project.LoadProjectSettings(projectName); projectSettings.HourlyRate != nil {
+						ui.PrintMuted(4, fmt.Sprintf("Entries use the configured rate of %s.", formatRate(projectSettings.HourlyRate, projectSettings.CurrencyCode())))
+					}
+				}
+				ui.NewlineBelow()
+				return
+			}
+
+			if listAll {
+				ui.PrintSuccess(ui.EmojiRate, "All Rates")
+			} else {
+				ui.PrintSuccess(ui.EmojiRate, fmt.Sprintf("Rates for %s", ui.Bold(projectName)))
+			}
+			fmt.Println()
+
+			fmt.Printf("  %s\n", ui.Muted(fmt.Sprintf("%-6s %-12s %-14s %s", "ID", "From", "Rate", "Applies To")))
+			for _, rate := range rates {
+				scope := rate.Scope()
+				if listAll {
+					scope = fmt.Sprintf("%s (%s)", rate.ProjectName, scope)
+				}
+
+				fmt.Printf("  %-6s %-12s %-14s %s\n",
+					fmt.Sprintf("#%d", rate.ID),
+					settings.FormatDateDashed(rate.EffectiveFrom),
+					formatRate(&rate.HourlyRate, project.GetProjectCurrency(rate.ProjectName)),
+					scope)
+			}
+
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().StringVarP(&listProject, "project", "p", "", "List the rates of a specific global project")
+	cmd.Flags().BoolVarP(&listAll, "all", "a", false, "List the rates of all projects")
+	cmd.MarkFlagsMutuallyExclusive("project", "all")
+
+	return cmd
+}
@@ -0,0 +1,35 @@
+package rates
+
+import (
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/spf13/cobra"
+)
+
+func RateCmds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate",
+		Short: "Manage hourly rate schedules",
+		Long: `Manage a project's hourly rates over time.
+
+A rate takes effect on a given date and applies to entries started on or after
+it, so raising your rate doesn't change what earlier work earned. Rates can be
+set for a whole project, or for a single milestone or tag, which take
+precedence. Projects without a scheduled rate use the hourly_rate from their
+configuration.`,
+	}
+
+	cmd.AddCommand(SetCmd())
+	cmd.AddCommand(ListCmd())
+	cmd.AddCommand(DeleteCmd())
+	cmd.AddCommand(ApplyCmd())
+
+	return cmd
+}
+
+func formatRate(rate *float64, currencyCode string) string {
+	if rate == nil {
+		return "none"
+	}
+
+	return settings.FormatCurrency(*rate, currencyCode) + "/h"
+}
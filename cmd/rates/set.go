@@ -0,0 +1,107 @@
+package rates
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	setProject   string
+	setFrom      string
+	setMilestone string
+	setTag       string
+)
+
+func SetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <rate>",
+		Short: "Schedule an hourly rate",
+		Long: `Schedule an hourly rate for the current project, effective from today or
+from the date given with --from.
+
+New entries pick up the rate in effect when they start. Use 'tmpo rate apply'
+to recompute the rates of entries that already exist.
+
+Examples:
+  tmpo rate set 120 --from 2026-01-01
+  tmpo rate set 150 --milestone "Launch"
+  tmpo rate set 80 --tag support`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			hourlyRate, err := strconv.ParseFloat(strings.TrimSpace(args[0]), 64)
+			if err != nil || hourlyRate < 0 {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid rate '%s', use a number like 120 or 87.50", args[0]))
+				os.Exit(1)
+			}
+
+			projectName, err := project.DetectConfiguredProjectWithOverride(setProject)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+				os.Exit(1)
+			}
+
+			now := time.Now().In(settings.GetDisplayTimezone())
+			effectiveFrom := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+			if setFrom != "" {
+				effectiveFrom, err = settings.ParseDate(setFrom, settings.DateLayoutDashed())
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid date '%s': %v", setFrom, err))
+					os.Exit(1)
+				}
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			var milestoneID *int64
+			if setMilestone != "" {
+				milestone, err := db.GetMilestoneByName(projectName, setMilestone)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+				if milestone == nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("milestone '%s' not found for project '%s'", setMilestone, projectName))
+					os.Exit(1)
+				}
+				milestoneID = &milestone.ID
+			}
+
+			rate, err := db.AddRate(projectName, hourlyRate, effectiveFrom, milestoneID, setTag)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiRate, fmt.Sprintf("Scheduled %s for %s", ui.Bold(formatRate(&rate.HourlyRate, project.GetProjectCurrency(projectName))), ui.Bold(projectName)))
+			ui.PrintInfo(4, ui.Bold("Applies To"), rate.Scope())
+			ui.PrintInfo(4, ui.Bold("Effective From"), settings.FormatDateDashed(rate.EffectiveFrom))
+			ui.PrintMuted(4, "Run 'tmpo rate apply --range FROM..' to update existing entries.")
+
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().StringVarP(&setProject, "project", "p", "", "Schedule the rate for a specific global project")
+	cmd.Flags().StringVar(&setFrom, "from", "", "Date the rate takes effect (default: today)")
+	cmd.Flags().StringVarP(&setMilestone, "milestone", "m", "", "Only apply the rate to entries of this milestone")
+	cmd.Flags().StringVarP(&setTag, "tag", "t", "", "Only apply the rate to entries with this tag")
+	cmd.MarkFlagsMutuallyExclusive("milestone", "tag")
+
+	return cmd
+}
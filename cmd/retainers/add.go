@@ -0,0 +1,94 @@
+package retainers
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/retainer"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	addProject string
+	addDate    string
+	addNote    string
+)
+
+func AddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <hours>",
+		Short: "Add prepaid hours to a project",
+		Long: `Add a block of prepaid hours to a project's retainer. Billable time tracked on
+the project from the first top-up on draws it down. The date defaults to today.
+
+Examples:
+  tmpo retainer add 20h
+  tmpo retainer add --project "Acme Corp" 40h --note "Invoice 2024-07"
+  tmpo retainer add 10 --date 2024-07-01`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			hours, err := parseHours(args[0])
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			projectName, err := project.DetectConfiguredProjectWithOverride(addProject)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+				os.Exit(1)
+			}
+
+			now := time.Now().In(settings.GetDisplayTimezone())
+			date := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+			if addDate != "" {
+				date, err = settings.ParseDate(addDate, settings.DateLayoutDashed())
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("invalid date '%s': %v", addDate, err))
+					os.Exit(1)
+				}
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			topUp, err := db.AddRetainerTopUp(projectName, hours, date, addNote)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			balance, err := retainer.Load(db, projectName)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiRetainer, fmt.Sprintf("Added %s to %s", ui.Bold(retainer.FormatHours(topUp.Hours)), ui.Bold(projectName)))
+			ui.PrintInfo(4, ui.Bold("Date"), settings.FormatDateDashed(topUp.Date))
+			if topUp.Note != "" {
+				ui.PrintInfo(4, ui.Bold("Note"), topUp.Note)
+			}
+			printBalance(projectName, balance)
+
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().StringVarP(&addProject, "project", "p", "", "Add the hours to a specific project")
+	cmd.Flags().StringVar(&addDate, "date", "", "Date the hours were bought (default: today)")
+	cmd.Flags().StringVarP(&addNote, "note", "n", "", "Note such as an invoice number")
+
+	return cmd
+}
@@ -0,0 +1,107 @@
+package retainers
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/retainer"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var ledgerProject string
+
+func LedgerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ledger",
+		Short: "Show a project's retainer ledger",
+		Long: `Show the top-ups of the current project's retainer and the billable entries that
+drew it down, in chronological order with the running balance.
+
+Examples:
+  tmpo retainer ledger
+  tmpo retainer ledger --project "Acme Corp"`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			projectName, err := project.DetectConfiguredProjectWithOverride(ledgerProject)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+				os.Exit(1)
+			}
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			topUps, err := db.GetRetainerTopUps(projectName)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if len(topUps) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, fmt.Sprintf("No retainer for %s", projectName))
+				ui.PrintMuted(4, "Add prepaid hours with 'tmpo retainer add 20h'.")
+				ui.NewlineBelow()
+				return
+			}
+
+			entries, err := db.GetEntriesByProject(projectName)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			ui.PrintSuccess(ui.EmojiRetainer, fmt.Sprintf("Retainer ledger for %s", ui.Bold(projectName)))
+			fmt.Println()
+
+			fmt.Printf("  %s\n", ui.Muted(fmt.Sprintf("%-12s %-8s %9s %9s  %s", "Date", "ID", "Hours", "Balance", "Description")))
+			for _, line := range retainer.Ledger(topUps, entries) {
+				id, hours, description := "", retainer.FormatHours(line.Hours), ""
+				if line.TopUp != nil {
+					id = fmt.Sprintf("#%d", line.TopUp.ID)
+					hours = "+" + hours
+					description = "Top-up"
+					if line.TopUp.Note != "" {
+						description += ": " + line.TopUp.Note
+					}
+				} else {
+					id = fmt.Sprintf("entry %d", line.Entry.ID)
+					description = line.Entry.Description
+					if line.Entry.IsRunning() {
+						description += " " + ui.Muted("[running]")
+					}
+				}
+
+				balance := fmt.Sprintf("%9s", retainer.FormatHours(line.Balance))
+				if line.Balance < 0 {
+					balance = ui.Warning(balance)
+				}
+
+				fmt.Printf("  %-12s %-8s %9s %s  %s\n",
+					settings.FormatDateDashed(line.Date),
+					id,
+					hours,
+					balance,
+					description)
+			}
+
+			fmt.Println()
+			printBalance(projectName, retainer.New(topUps, entries))
+
+			ui.NewlineBelow()
+		},
+	}
+
+	cmd.Flags().StringVarP(&ledgerProject, "project", "p", "", "Show the ledger of a specific project")
+
+	return cmd
+}
@@ -0,0 +1,85 @@
+package retainers
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/DylanDevelops/tmpo/internal/retainer"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func ListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List retainer balances",
+		Long:  `List the prepaid hours, hours used and balance of every project with a retainer.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+			defer db.Close()
+
+			balances, err := retainer.LoadAll(db)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if len(balances) == 0 {
+				ui.PrintWarning(ui.EmojiWarning, "No retainers found")
+				ui.PrintMuted(4, "Add prepaid hours with 'tmpo retainer add 20h'.")
+				ui.NewlineBelow()
+				return
+			}
+
+			projectNames := make([]string, 0, len(balances))
+			for projectName := range balances {
+				projectNames = append(projectNames, projectName)
+			}
+			sort.Strings(projectNames)
+
+			ui.PrintSuccess(ui.EmojiRetainer, fmt.Sprintf("Retainers (%d)", len(balances)))
+			fmt.Println()
+
+			fmt.Printf("  %s\n", ui.Muted(fmt.Sprintf("%-24s %10s %10s %10s", "Project", "Bought", "Used", "Balance")))
+			var warnings []string
+			for _, projectName := range projectNames {
+				balance := balances[projectName]
+
+				remaining := fmt.Sprintf("%10s", retainer.FormatHours(balance.Remaining()))
+				if balance.IsLow() {
+					remaining = ui.Warning(remaining)
+				}
+
+				fmt.Printf("  %-24s %10s %10s %s\n",
+					projectName,
+					retainer.FormatHours(balance.Purchased),
+					retainer.FormatHours(balance.Used),
+					remaining)
+
+				if warning := balance.Warning(projectName); warning != "" {
+					warnings = append(warnings, warning)
+				}
+			}
+
+			if len(warnings) > 0 {
+				fmt.Println()
+				for _, warning := range warnings {
+					ui.PrintWarning(ui.EmojiWarning, warning)
+				}
+			}
+
+			ui.NewlineBelow()
+		},
+	}
+
+	return cmd
+}
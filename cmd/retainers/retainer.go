@@ -0,0 +1,77 @@
+package retainers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/retainer"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+func RetainerCmds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retainer",
+		Short: "Manage prepaid hours",
+		Long: `Track blocks of hours a client paid for in advance. Billable time tracked on the
+project draws down the balance, which is shown in 'tmpo status' and 'tmpo stats'.`,
+	}
+
+	cmd.AddCommand(AddCmd())
+	cmd.AddCommand(ListCmd())
+	cmd.AddCommand(LedgerCmd())
+	cmd.AddCommand(DeleteCmd())
+
+	return cmd
+}
+
+// parseHours parses prepaid hours like "20h", "7.5" or "1h30m".
+func parseHours(input string) (float64, error) {
+	input = strings.TrimSpace(input)
+
+	hours, err := strconv.ParseFloat(strings.TrimSuffix(input, "h"), 64)
+	if err != nil {
+		duration, durationErr := time.ParseDuration(input)
+		if durationErr != nil {
+			return 0, fmt.Errorf("invalid hours '%s', use a number of hours like 20h or 7.5", input)
+		}
+		hours = duration.Hours()
+	}
+
+	if hours <= 0 {
+		return 0, fmt.Errorf("hours must be greater than 0")
+	}
+
+	return hours, nil
+}
+
+// formatTopUp summarizes a top-up for prompts and messages.
+func formatTopUp(topUp *storage.RetainerTopUp) string {
+	summary := fmt.Sprintf("#%d %s for %s on %s",
+		topUp.ID,
+		retainer.FormatHours(topUp.Hours),
+		topUp.ProjectName,
+		settings.FormatDateDashed(topUp.Date))
+
+	if topUp.Note != "" {
+		summary += fmt.Sprintf(" (%s)", topUp.Note)
+	}
+
+	return summary
+}
+
+// printBalance prints a project's balance and warns when it runs low.
+func printBalance(projectName string, balance *retainer.Balance) {
+	if balance == nil {
+		return
+	}
+
+	ui.PrintInfo(4, ui.Bold("Balance"), balance.String())
+	if warning := balance.Warning(projectName); warning != "" {
+		ui.PrintWarning(ui.EmojiWarning, warning)
+	}
+}
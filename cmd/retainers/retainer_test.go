@@ -0,0 +1,21 @@
+package retainers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHours(t *testing.T) {
+	for input, expected := range map[string]float64{"20h": 20, "7.5": 7.5, " 1h30m ": 1.5} {
+		hours, err := parseHours(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, hours, input)
+	}
+
+	for _, input := range []string{"", "0h", "-5", "lots"} {
+		_, err := parseHours(input)
+		assert.Error(t, err, input)
+	}
+}
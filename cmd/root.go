@@ -5,8 +5,14 @@ import (
 
 	"github.com/DylanDevelops/tmpo/cmd/config"
 	"github.com/DylanDevelops/tmpo/cmd/entries"
+	"github.com/DylanDevelops/tmpo/cmd/expenses"
+	"github.com/DylanDevelops/tmpo/cmd/fx"
+	"github.com/DylanDevelops/tmpo/cmd/git"
 	"github.com/DylanDevelops/tmpo/cmd/history"
 	"github.com/DylanDevelops/tmpo/cmd/milestones"
+	"github.com/DylanDevelops/tmpo/cmd/projects"
+	"github.com/DylanDevelops/tmpo/cmd/rates"
+	"github.com/DylanDevelops/tmpo/cmd/retainers"
 	"github.com/DylanDevelops/tmpo/cmd/setup"
 	"github.com/DylanDevelops/tmpo/cmd/tracking"
 	"github.com/DylanDevelops/tmpo/cmd/utilities"
@@ -46,16 +52,22 @@ Track time effortlessly with automatic project detection and simple commands.`,
 	cmd.AddCommand(tracking.PauseCmd())
 	cmd.AddCommand(tracking.ResumeCmd())
 	cmd.AddCommand(tracking.StatusCmd())
-	
+	cmd.AddCommand(tracking.PomodoroCmd())
+	cmd.AddCommand(tracking.PromptCmd())
+
 	// History
 	cmd.AddCommand(history.LogCmd())
 	cmd.AddCommand(history.StatsCmd())
 	cmd.AddCommand(history.ExportCmd())
-	
+	cmd.AddCommand(history.SearchCmd())
+
 	// Entries
 	cmd.AddCommand(entries.EditCmd())
 	cmd.AddCommand(entries.DeleteCmd())
+	cmd.AddCommand(entries.NoteCmd())
 	cmd.AddCommand(entries.ManualCmd())
+	cmd.AddCommand(entries.ReconstructCmd())
+	cmd.AddCommand(entries.EntryCmds())
 
 	// Setup
 	cmd.AddCommand(setup.InitCmd())
@@ -66,6 +78,22 @@ Track time effortlessly with automatic project detection and simple commands.`,
 	// Milestones
 	cmd.AddCommand(milestones.MilestoneCmds())
 
+	// Projects
+	cmd.AddCommand(projects.ProjectCmds())
+
+	// Rates
+	cmd.AddCommand(rates.RateCmds())
+	cmd.AddCommand(fx.FXCmds())
+
+	// Expenses
+	cmd.AddCommand(expenses.ExpenseCmds())
+
+	// Retainers
+	cmd.AddCommand(retainers.RetainerCmds())
+
+	// Git integration
+	cmd.AddCommand(git.GitCmds())
+
 	return cmd
 }
 
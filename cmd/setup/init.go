@@ -89,6 +89,8 @@ func initGlobalProject() {
 		os.Exit(1)
 	}
 
+	paths := getProjectPaths()
+
 	// create the project
 	var hourlyRatePtr *float64
 	if hourlyRate > 0 {
@@ -100,6 +102,7 @@ func initGlobalProject() {
 		HourlyRate:  hourlyRatePtr,
 		Description: description,
 		ExportPath:  exportPath,
+		Paths:       paths,
 	}
 
 	err = registry.AddProject(newProject)
@@ -117,8 +120,14 @@ func initGlobalProject() {
 	fmt.Println()
 	ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("Created global project %s", ui.Bold(name)))
 	printProjectDetails(hourlyRate, description, exportPath)
+	if len(paths) > 0 {
+		ui.PrintInfo(4, ui.Bold("Paths"), strings.Join(paths, ", "))
+	}
 
 	fmt.Println()
+	if len(paths) > 0 {
+		ui.PrintMuted(0, "This project is detected automatically inside its paths.")
+	}
 	ui.PrintMuted(0, "You can now track time for this project from any directory:")
 	ui.PrintMuted(0, fmt.Sprintf("  tmpo start --project \"%s\"", name))
 	ui.PrintMuted(0, "")
@@ -219,6 +228,22 @@ func getProjectDetails(defaultName, title string) (name string, hourlyRate float
 	return
 }
 
+// getProjectPaths asks for the directories a global project should be detected
+// in. Relative paths are resolved against the current directory.
+func getProjectPaths() []string {
+	pathsPrompt := promptui.Prompt{
+		Label: "Project paths, comma-separated (press Enter to skip)",
+	}
+
+	pathsInput, err := pathsPrompt.Run()
+	if err != nil {
+		ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+		os.Exit(1)
+	}
+
+	return settings.ParseProjectPaths(pathsInput)
+}
+
 func printProjectDetails(hourlyRate float64, description, exportPath string) {
 	if hourlyRate > 0 {
 		ui.PrintInfo(4, ui.Bold("Hourly Rate"), fmt.Sprintf("%.2f", hourlyRate))
@@ -0,0 +1,331 @@
+package tracking
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/pomodoro"
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pomodoroProjectFlag    string
+	pomodoroFocus          time.Duration
+	pomodoroShortBreak     time.Duration
+	pomodoroLongBreak      time.Duration
+	pomodoroLongBreakEvery int
+	pomodoroRounds         int
+	pomodoroTags           []string
+	pomodoroHook           string
+	pomodoroNoBell         bool
+)
+
+type countdownResult int
+
+const (
+	countdownFinished countdownResult = iota
+	countdownInterrupted
+	countdownStoppedElsewhere
+)
+
+func PomodoroCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pomodoro [description]",
+		Short: "Track time in pomodoro intervals",
+		Long: `Run focus intervals separated by short and long breaks.
+
+Each focus block is recorded as its own time entry. Blocks that run to completion
+are tagged "pomodoro" and counted in 'tmpo stats'. Press Ctrl+C to stop; an
+interrupted focus block is kept as a regular entry.`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ui.NewlineAbove()
+
+			db, err := storage.Initialize()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			defer db.Close()
+
+			running, err := db.GetRunningEntry()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if running != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("Already tracking time for `%s`", running.ProjectName))
+				ui.PrintMuted(0, "Use 'tmpo stop' to stop the current session first.")
+				ui.NewlineBelow()
+				os.Exit(1)
+			}
+
+			projectName, err := project.DetectConfiguredProjectWithOverride(pomodoroProjectFlag)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("detecting project: %v", err))
+				os.Exit(1)
+			}
+
+			description := ""
+			if len(args) > 0 {
+				description = args[0]
+			}
+
+			globalCfg, err := settings.LoadGlobalConfig()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("loading config: %v", err))
+				os.Exit(1)
+			}
+
+			pomodoroSettings := resolvePomodoroSettings(cmd, globalCfg.Pomodoro)
+			if err := pomodoroSettings.Validate(); err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if pomodoroRounds < 0 {
+				ui.PrintError(ui.EmojiError, "rounds cannot be negative")
+				os.Exit(1)
+			}
+
+			hook := globalCfg.Pomodoro.Hook
+			if cmd.Flags().Changed("hook") {
+				hook = pomodoroHook
+			}
+			bell := !globalCfg.Pomodoro.DisableBell && !pomodoroNoBell
+
+			var hourlyRate *float64
+			configRate, _, err := project.GetProjectConfig(projectName)
+			if err == nil && configRate != nil {
+				hourlyRate = configRate
+			}
+
+			var milestoneID *int64
+			if activeMilestone, _ := db.GetActiveMilestoneForProject(projectName); activeMilestone != nil {
+				milestoneID = &activeMilestone.ID
+			}
+
+			userTags := storage.NormalizeTags(pomodoroTags)
+
+			// focus blocks end up tagged, so tag rates apply to them
+			if scheduledRate, err := db.ResolveRate(projectName, milestoneID, append(append([]string{}, userTags...), pomodoro.Tag), time.Now()); err == nil && scheduledRate != nil {
+				hourlyRate = scheduledRate
+			}
+
+			notify := func(finished, next pomodoro.Phase, completed int) {
+				if bell {
+					fmt.Print("\a")
+				}
+
+				if err := pomodoro.RunHook(hook, finished, next, projectName, completed); err != nil {
+					ui.PrintWarning(ui.EmojiWarning, fmt.Sprintf("%v", err))
+				}
+			}
+
+			interrupt := make(chan os.Signal, 1)
+			signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+			defer signal.Stop(interrupt)
+
+			ui.PrintSuccess(ui.EmojiPomodoro, fmt.Sprintf("Pomodoro for %s", ui.Bold(projectName)))
+			ui.PrintMuted(4, fmt.Sprintf("└─ %s focus, %s short break, %s long break every %d",
+				ui.FormatDuration(pomodoroSettings.Focus),
+				ui.FormatDuration(pomodoroSettings.ShortBreak),
+				ui.FormatDuration(pomodoroSettings.LongBreak),
+				pomodoroSettings.LongBreakEvery))
+			if description != "" {
+				ui.PrintInfo(4, "Description", description)
+			}
+			fmt.Println()
+
+			completed := 0
+			for round := 1; pomodoroRounds == 0 || round <= pomodoroRounds; round++ {
+				entry, err := startFocusEntry(db, projectName, description, hourlyRate, milestoneID, userTags)
+				if err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				label := fmt.Sprintf("%s #%d", pomodoro.PhaseFocus.Label(), round)
+				stillRunning := func() bool {
+					current, err := db.GetEntry(entry.ID)
+					return err == nil && current.IsRunning()
+				}
+
+				switch runCountdown(label, pomodoroSettings.Focus, interrupt, stillRunning) {
+				case countdownInterrupted:
+					if err := db.StopEntry(entry.ID); err != nil {
+						ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+						os.Exit(1)
+					}
+					ui.PrintWarning(ui.EmojiStop, fmt.Sprintf("%s interrupted after %s (kept as a regular entry)", label, ui.FormatDuration(time.Since(entry.StartTime))))
+					printPomodoroSummary(completed, pomodoroSettings.Focus)
+					return
+				case countdownStoppedElsewhere:
+					ui.PrintWarning(ui.EmojiWarning, fmt.Sprintf("%s was stopped from another session", label))
+					printPomodoroSummary(completed, pomodoroSettings.Focus)
+					return
+				}
+
+				if err := completeFocusEntry(db, entry.ID, pomodoroSettings.Focus); err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+
+				completed++
+				ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("%s complete", label))
+
+				if pomodoroRounds != 0 && round == pomodoroRounds {
+					notify(pomodoro.PhaseFocus, pomodoro.PhaseDone, completed)
+					break
+				}
+
+				breakPhase, breakLength := pomodoroSettings.BreakAfter(completed)
+				notify(pomodoro.PhaseFocus, breakPhase, completed)
+
+				if breakLength > 0 {
+					if runCountdown(breakPhase.Label(), breakLength, interrupt, nil) == countdownInterrupted {
+						ui.PrintWarning(ui.EmojiStop, fmt.Sprintf("%s skipped", breakPhase.Label()))
+						printPomodoroSummary(completed, pomodoroSettings.Focus)
+						return
+					}
+					ui.PrintSuccess(ui.EmojiSuccess, fmt.Sprintf("%s over", breakPhase.Label()))
+					notify(breakPhase, pomodoro.PhaseFocus, completed)
+				}
+			}
+
+			printPomodoroSummary(completed, pomodoroSettings.Focus)
+		},
+	}
+
+	defaults := pomodoro.DefaultSettings()
+	cmd.Flags().StringVarP(&pomodoroProjectFlag, "project", "p", "", "Track time for a specific global project")
+	cmd.Flags().DurationVar(&pomodoroFocus, "focus", defaults.Focus, "Length of each focus block")
+	cmd.Flags().DurationVar(&pomodoroShortBreak, "short-break", defaults.ShortBreak, "Length of short breaks")
+	cmd.Flags().DurationVar(&pomodoroLongBreak, "long-break", defaults.LongBreak, "Length of long breaks")
+	cmd.Flags().IntVar(&pomodoroLongBreakEvery, "long-break-every", defaults.LongBreakEvery, "Take a long break after this many focus blocks")
+	cmd.Flags().IntVarP(&pomodoroRounds, "rounds", "n", 0, "Number of focus blocks to run (0 runs until interrupted)")
+	cmd.Flags().StringSliceVarP(&pomodoroTags, "tag", "t", nil, "Tag to add to each focus entry (repeatable)")
+	cmd.Flags().StringVar(&pomodoroHook, "hook", "", "Shell command to run at each phase transition")
+	cmd.Flags().BoolVar(&pomodoroNoBell, "no-bell", false, "Don't ring the terminal bell at phase transitions")
+
+	return cmd
+}
+
+// startFocusEntry starts the entry tracking a focus phase, billable according
+// to the project's default.
+func startFocusEntry(db *storage.Database, projectName, description string, hourlyRate *float64, milestoneID *int64, tags []string) (*storage.TimeEntry, error) {
+	entry, err := db.CreateEntry(projectName, description, hourlyRate, milestoneID, project.IsProjectBillable(projectName))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) > 0 {
+		if err := db.SetEntryTags(entry.ID, tags); err != nil {
+			return nil, err
+		}
+		entry.Tags = storage.NormalizeTags(tags)
+	}
+
+	return entry, nil
+}
+
+// completeFocusEntry ends a focus entry after exactly one focus block, even if
+// the countdown overshot (e.g. the machine slept), and tags it as a completed
+// pomodoro. The entry is read again so changes made to it during the block,
+// like notes or an edited description, are kept.
+func completeFocusEntry(db *storage.Database, id int64, focus time.Duration) error {
+	entry, err := db.GetEntry(id)
+	if err != nil {
+		return err
+	}
+
+	if err := db.SetEntryEndTime(id, entry.StartTime.Add(focus)); err != nil {
+		return err
+	}
+
+	return db.SetEntryTags(id, append(entry.Tags, pomodoro.Tag))
+}
+
+// resolvePomodoroSettings layers explicit flags over the global config over the
+// built-in defaults.
+func resolvePomodoroSettings(cmd *cobra.Command, cfg settings.PomodoroConfig) pomodoro.Settings {
+	resolved := pomodoro.DefaultSettings()
+
+	if cfg.FocusMinutes > 0 {
+		resolved.Focus = time.Duration(cfg.FocusMinutes) * time.Minute
+	}
+	if cfg.ShortBreakMinutes > 0 {
+		resolved.ShortBreak = time.Duration(cfg.ShortBreakMinutes) * time.Minute
+	}
+	if cfg.LongBreakMinutes > 0 {
+		resolved.LongBreak = time.Duration(cfg.LongBreakMinutes) * time.Minute
+	}
+	if cfg.LongBreakEvery > 0 {
+		resolved.LongBreakEvery = cfg.LongBreakEvery
+	}
+
+	if cmd.Flags().Changed("focus") {
+		resolved.Focus = pomodoroFocus
+	}
+	if cmd.Flags().Changed("short-break") {
+		resolved.ShortBreak = pomodoroShortBreak
+	}
+	if cmd.Flags().Changed("long-break") {
+		resolved.LongBreak = pomodoroLongBreak
+	}
+	if cmd.Flags().Changed("long-break-every") {
+		resolved.LongBreakEvery = pomodoroLongBreakEvery
+	}
+
+	return resolved
+}
+
+// runCountdown renders a single-line countdown until the duration elapses, the
+// user interrupts, or stillRunning (checked every second when non-nil) reports
+// that the entry was stopped elsewhere.
+func runCountdown(label string, length time.Duration, interrupt <-chan os.Signal, stillRunning func() bool) countdownResult {
+	deadline := time.Now().Add(length)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	render := func() {
+		fmt.Printf(clearLine+"    %s  %s", ui.Bold(label), ui.Info(pomodoro.FormatCountdown(time.Until(deadline))))
+	}
+
+	render()
+	for {
+		select {
+		case <-interrupt:
+			fmt.Print(clearLine)
+			return countdownInterrupted
+		case <-ticker.C:
+			if stillRunning != nil && !stillRunning() {
+				fmt.Print(clearLine)
+				return countdownStoppedElsewhere
+			}
+
+			if !time.Now().Before(deadline) {
+				fmt.Print(clearLine)
+				return countdownFinished
+			}
+
+			render()
+		}
+	}
+}
+
+func printPomodoroSummary(completed int, focus time.Duration) {
+	fmt.Println()
+	ui.PrintInfo(4, ui.Bold("Completed Pomodoros"), fmt.Sprintf("%d", completed))
+	ui.PrintInfo(4, ui.Bold("Focus Time"), ui.FormatDuration(time.Duration(completed)*focus))
+	ui.NewlineBelow()
+}
@@ -0,0 +1,63 @@
+package tracking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/pomodoro"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartFocusEntryUsesProjectBillableDefault(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("TMPO_DEV", "")
+
+	nonBillable := false
+	registry, err := settings.LoadProjects()
+	require.NoError(t, err)
+	require.NoError(t, registry.AddProject(settings.GlobalProject{Name: "internal", Billable: &nonBillable}))
+	require.NoError(t, registry.Save())
+
+	db, err := storage.Initialize()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry, err := startFocusEntry(db, "internal", "Planning", nil, nil, []string{"pomodoro"})
+	require.NoError(t, err)
+	assert.False(t, entry.Billable)
+	assert.Equal(t, []string{"pomodoro"}, entry.Tags)
+	require.NoError(t, db.StopEntry(entry.ID))
+
+	entry, err = startFocusEntry(db, "client", "Feature work", nil, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, entry.Billable)
+}
+
+func TestCompleteFocusEntryKeepsChangesMadeDuringTheBlock(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("TMPO_DEV", "")
+
+	db, err := storage.Initialize()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry, err := startFocusEntry(db, "web", "Parser", nil, nil, []string{"deep-work"})
+	require.NoError(t, err)
+
+	// changed from another terminal while the block runs
+	require.NoError(t, db.SetEntryNotes(entry.ID, "Handled the edge cases."))
+	require.NoError(t, db.SetEntryBillable(entry.ID, false))
+
+	require.NoError(t, completeFocusEntry(db, entry.ID, 25*time.Minute))
+
+	completed, err := db.GetEntry(entry.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Handled the edge cases.", completed.Notes)
+	assert.False(t, completed.Billable)
+	assert.Equal(t, []string{"deep-work", pomodoro.Tag}, completed.Tags)
+	require.NotNil(t, completed.EndTime)
+	assert.Equal(t, 25*time.Minute, completed.Duration())
+}
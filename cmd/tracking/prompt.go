@@ -0,0 +1,181 @@
+package tracking
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+const defaultPromptFormat = "{{.Project}} {{.Elapsed}}"
+
+var (
+	promptFormat string
+	promptEmpty  string
+)
+
+func PromptCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prompt",
+		Short: "Print the running timer for shell prompts and status bars",
+		Long: `Print a short, unstyled line describing the running session, meant to be
+embedded in shell prompts, tmux status lines and status bars.
+
+The --format flag takes a Go template. Available fields:
+  {{.Project}}      project name
+  {{.Description}}  entry description
+  {{.Milestone}}    milestone name
+  {{.Issue}}        issue key derived from the git branch
+  {{.Tags}}         comma separated tags
+  {{.Elapsed}}      elapsed time (e.g. 1h 23m 4s)
+  {{.Clock}}        elapsed time as H:MM
+  {{.Hours}}        elapsed hours (e.g. 1.38)
+  {{.Start}}        start time
+  {{.Earnings}}     running earnings (empty without an hourly rate)
+
+Nothing is printed when no session is running, unless --empty is set.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			tmpl, err := parsePromptTemplate(promptFormat)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			running, err := getRunningEntryFast()
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if running == nil {
+				if promptEmpty != "" {
+					fmt.Println(promptEmpty)
+				}
+				return
+			}
+
+			output, err := renderPrompt(tmpl, running)
+			if err != nil {
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			fmt.Println(output)
+		},
+	}
+
+	cmd.Flags().StringVarP(&promptFormat, "format", "f", defaultPromptFormat, "Go template for the output")
+	cmd.Flags().StringVar(&promptEmpty, "empty", "", "Text to print when no session is running")
+
+	return cmd
+}
+
+// getRunningEntryFast reads the running entry without the schema setup done by
+// storage.Initialize. It falls back to Initialize when the database predates
+// the columns the query needs, which also migrates it for the next call.
+func getRunningEntryFast() (*storage.TimeEntry, error) {
+	db, err := storage.OpenReadOnly()
+	if errors.Is(err, storage.ErrNoDatabase) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	running, err := db.GetRunningEntry()
+	db.Close()
+	if err == nil {
+		return running, nil
+	}
+
+	db, err = storage.Initialize()
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	return db.GetRunningEntry()
+}
+
+func parsePromptTemplate(format string) (*template.Template, error) {
+	tmpl, err := template.New("prompt").Option("missingkey=error").Parse(format)
+	if err != nil {
+		return nil, fmt.Errorf("invalid format: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+func renderPrompt(tmpl *template.Template, entry *storage.TimeEntry) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, promptData{entry: entry}); err != nil {
+		return "", fmt.Errorf("invalid format: %w", err)
+	}
+
+	return strings.TrimRight(buf.String(), "\n"), nil
+}
+
+// promptData exposes the running entry to prompt templates. Fields are methods
+// so values that need extra work (like loading the currency for earnings) are
+// only computed when the template uses them.
+type promptData struct {
+	entry *storage.TimeEntry
+}
+
+func (p promptData) Project() string {
+	return p.entry.ProjectName
+}
+
+func (p promptData) Description() string {
+	return p.entry.Description
+}
+
+func (p promptData) Milestone() string {
+	if p.entry.MilestoneName == nil {
+		return ""
+	}
+
+	return *p.entry.MilestoneName
+}
+
+func (p promptData) Issue() string {
+	return p.entry.IssueKey
+}
+
+func (p promptData) Tags() string {
+	return strings.Join(p.entry.Tags, ",")
+}
+
+func (p promptData) Elapsed() string {
+	return ui.FormatDuration(p.entry.Duration())
+}
+
+func (p promptData) Clock() string {
+	elapsed := p.entry.Duration()
+	return fmt.Sprintf("%d:%02d", int(elapsed.Hours()), int(elapsed.Minutes())%60)
+}
+
+func (p promptData) Hours() string {
+	return fmt.Sprintf("%.2f", p.entry.RoundedHours())
+}
+
+func (p promptData) Start() string {
+	return settings.FormatTime(p.entry.StartTime)
+}
+
+func (p promptData) Earnings() string {
+	if !p.entry.IsBilled() {
+		return ""
+	}
+
+	return settings.FormatCurrency(p.entry.Earnings(), project.GetProjectCurrency(p.entry.ProjectName))
+}
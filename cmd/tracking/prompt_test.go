@@ -0,0 +1,73 @@
+package tracking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderPrompt(t *testing.T) {
+	rate := 60.0
+	milestone := "Sprint 1"
+	entry := &storage.TimeEntry{
+		ProjectName:   "my-project",
+		StartTime:     time.Now().Add(-(90*time.Minute + 30*time.Second)),
+		Description:   "Login form",
+		HourlyRate:    &rate,
+		Billable:      true,
+		MilestoneName: &milestone,
+		Tags:          []string{"frontend", "pomodoro"},
+	}
+
+	tests := []struct {
+		name     string
+		format   string
+		expected string
+	}{
+		{"default format", defaultPromptFormat, "my-project 1h 30m 30s"},
+		{"clock", "{{.Project}} {{.Clock}}", "my-project 1:30"},
+		{"hours", "{{.Hours}}h", "1.51h"},
+		{"description and milestone", "{{.Description}} ({{.Milestone}})", "Login form (Sprint 1)"},
+		{"tags", "{{.Tags}}", "frontend,pomodoro"},
+		{"conditional", "{{if .Milestone}}[{{.Milestone}}]{{end}}", "[Sprint 1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := parsePromptTemplate(tt.format)
+			require.NoError(t, err)
+
+			output, err := renderPrompt(tmpl, entry)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, output)
+		})
+	}
+}
+
+func TestRenderPromptWithoutOptionalFields(t *testing.T) {
+	entry := &storage.TimeEntry{
+		ProjectName: "my-project",
+		StartTime:   time.Now().Add(-5 * time.Minute),
+	}
+
+	tmpl, err := parsePromptTemplate("{{.Project}}|{{.Milestone}}|{{.Earnings}}")
+	require.NoError(t, err)
+
+	output, err := renderPrompt(tmpl, entry)
+	require.NoError(t, err)
+	assert.Equal(t, "my-project||", output)
+}
+
+func TestParsePromptTemplateErrors(t *testing.T) {
+	_, err := parsePromptTemplate("{{.Project")
+	assert.Error(t, err)
+
+	tmpl, err := parsePromptTemplate("{{.Unknown}}")
+	require.NoError(t, err)
+
+	_, err = renderPrompt(tmpl, &storage.TimeEntry{ProjectName: "p", StartTime: time.Now()})
+	assert.Error(t, err)
+}
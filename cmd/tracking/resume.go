@@ -62,7 +62,8 @@ func ResumeCmd() *cobra.Command {
 				os.Exit(1)
 			}
 
-			entry, err := db.CreateEntry(lastStopped.ProjectName, lastStopped.Description, lastStopped.HourlyRate, lastStopped.MilestoneName)
+			// the resumed session continues the same work, billable or not
+			entry, err := db.CreateEntry(lastStopped.ProjectName, lastStopped.Description, lastStopped.HourlyRate, lastStopped.MilestoneID, lastStopped.Billable)
 			if err != nil {
 				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
 				os.Exit(1)
@@ -78,6 +79,10 @@ func ResumeCmd() *cobra.Command {
 				ui.PrintInfo(4, "Milestone", *entry.MilestoneName)
 			}
 
+			if !lastStopped.Billable {
+				ui.PrintInfo(4, "Billable", "no")
+			}
+
 			ui.NewlineBelow()
 		},
 	}
@@ -3,6 +3,7 @@ package tracking
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/DylanDevelops/tmpo/internal/project"
 	"github.com/DylanDevelops/tmpo/internal/settings"
@@ -13,6 +14,8 @@ import (
 
 var (
 	startProjectFlag string
+	startBillable    bool
+	startNonBillable bool
 )
 
 func StartCmd() *cobra.Command {
@@ -55,25 +58,72 @@ func StartCmd() *cobra.Command {
 				description = args[0]
 			}
 
-			var hourlyRate *float64
-			configRate, _, err := project.GetProjectConfig(projectName)
-			if err == nil && configRate != nil {
-				hourlyRate = configRate
+			// branch settings live in .tmporc, so they only apply to the local project
+			var branchInfo *project.BranchInfo
+			if startProjectFlag == "" {
+				branchInfo, err = project.DeriveFromBranch()
+				if err != nil {
+					ui.PrintWarning(ui.EmojiWarning, fmt.Sprintf("%v", err))
+				}
 			}
 
+			issueKey := ""
+			if branchInfo != nil {
+				if description == "" {
+					description = branchInfo.Description
+				}
+				issueKey = branchInfo.IssueKey
+			}
+
+			projectSettings := project.LoadProjectSettings(projectName)
+			hourlyRate := projectSettings.HourlyRate
+
+			var milestoneID *int64
 			var milestoneName *string
 			activeMilestone, err := db.GetActiveMilestoneForProject(projectName)
 
 			if activeMilestone != nil {
+				milestoneID = &activeMilestone.ID
 				milestoneName = &activeMilestone.Name
 			}
 
-			entry, err := db.CreateEntry(projectName, description, hourlyRate, milestoneName)
+			var branchMilestoneWarning string
+			if branchInfo != nil && branchInfo.Milestone != "" {
+				branchMilestone, _ := db.GetMilestoneByName(projectName, branchInfo.Milestone)
+				if branchMilestone != nil && branchMilestone.IsActive() {
+					milestoneID = &branchMilestone.ID
+					milestoneName = &branchMilestone.Name
+				} else {
+					branchMilestoneWarning = fmt.Sprintf("Branch milestone '%s' is not an active milestone. Use 'tmpo milestone switch \"%s\"' to start it.", branchInfo.Milestone, branchInfo.Milestone)
+				}
+			}
+
+			// a scheduled rate takes precedence over the configured one
+			if scheduledRate, err := db.ResolveRate(projectName, milestoneID, nil, time.Now()); err == nil && scheduledRate != nil {
+				hourlyRate = scheduledRate
+			}
+
+			// the flags override the project's default
+			billable := projectSettings.IsBillable()
+			if startBillable {
+				billable = true
+			} else if startNonBillable {
+				billable = false
+			}
+
+			entry, err := db.CreateEntry(projectName, description, hourlyRate, milestoneID, billable)
 			if err != nil {
 				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
 				os.Exit(1)
 			}
 
+			if issueKey != "" {
+				if err := db.SetEntryIssueKey(entry.ID, issueKey); err != nil {
+					ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+					os.Exit(1)
+				}
+			}
+
 			ui.PrintSuccess(ui.EmojiStart, fmt.Sprintf("Started tracking time for %s", ui.Bold(entry.ProjectName)))
 
 			// communicate config source to user
@@ -81,6 +131,8 @@ func StartCmd() *cobra.Command {
 				ui.PrintMuted(4, "└─ Config Source: global project")
 			} else if cfg, _, err := settings.FindAndLoad(); err == nil && cfg != nil {
 				ui.PrintMuted(4, "└─ Config Source: .tmporc")
+			} else if _, source := project.DetectPathProject(); source != "" {
+				ui.PrintMuted(4, fmt.Sprintf("└─ Config Source: %s", source))
 			} else if project.IsInGitRepo() {
 				ui.PrintMuted(4, "└─ Config Source: git repository")
 			} else {
@@ -91,15 +143,30 @@ func StartCmd() *cobra.Command {
 				ui.PrintInfo(4, "Description", description)
 			}
 
+			if issueKey != "" {
+				ui.PrintInfo(4, "Issue", issueKey)
+			}
+
 			if milestoneName != nil {
 				ui.PrintInfo(4, "Milestone", *milestoneName)
 			}
 
+			if !billable {
+				ui.PrintInfo(4, "Billable", "no")
+			}
+
+			if branchMilestoneWarning != "" {
+				ui.PrintMuted(4, branchMilestoneWarning)
+			}
+
 			ui.NewlineBelow()
 		},
 	}
 
 	cmd.Flags().StringVarP(&startProjectFlag, "project", "p", "", "Track time for a specific global project")
+	cmd.Flags().BoolVar(&startBillable, "billable", false, "Track billable time, even if the project is non-billable by default")
+	cmd.Flags().BoolVar(&startNonBillable, "non-billable", false, "Track non-billable time that doesn't count towards earnings")
+	cmd.MarkFlagsMutuallyExclusive("billable", "non-billable")
 
 	return cmd
 }
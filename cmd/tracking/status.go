@@ -1,21 +1,40 @@
 package tracking
 
 import (
+	"database/sql"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
+	"github.com/DylanDevelops/tmpo/internal/goals"
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/retainer"
 	"github.com/DylanDevelops/tmpo/internal/settings"
 	"github.com/DylanDevelops/tmpo/internal/storage"
 	"github.com/DylanDevelops/tmpo/internal/ui"
 	"github.com/spf13/cobra"
 )
 
+// clearLine moves the cursor to the start of the line and erases it so live
+// displays can redraw in place.
+const clearLine = "\r\033[2K"
+
+var (
+	statusWatch bool
+)
+
 func StatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Show current tracking status",
-		Long:  `Display information about the currently running time tracking session.`,
+		Long: `Display information about the currently running time tracking session.
+
+Use --watch to keep a live display that updates every second until Ctrl+C is
+pressed or the session is stopped from another terminal.`,
 
 		Run: func(cmd *cobra.Command, args []string) {
 			ui.NewlineAbove()
@@ -42,6 +61,11 @@ func StatusCmd() *cobra.Command {
 				return
 			}
 
+			if statusWatch {
+				watchStatus(db, running)
+				return
+			}
+
 			duration := time.Since(running.StartTime)
 
 			ui.PrintSuccess(ui.EmojiStatus, fmt.Sprintf("Currently tracking: %s", ui.Bold(running.ProjectName)))
@@ -52,13 +76,170 @@ func StatusCmd() *cobra.Command {
 				ui.PrintInfo(4, ui.Bold("Description"), running.Description)
 			}
 
+			if running.IssueKey != "" {
+				ui.PrintInfo(4, ui.Bold("Issue"), running.IssueKey)
+			}
+
 			if running.MilestoneName != nil && *running.MilestoneName != "" {
-				ui.PrintInfo(4, ui.Bold("Milestone"), *running.MilestoneName);
+				ui.PrintInfo(4, ui.Bold("Milestone"), *running.MilestoneName)
 			}
 
+			printGoalProgress(db, running.ProjectName)
+			printRetainerBalance(db, running.ProjectName)
+
 			ui.NewlineBelow()
 		},
 	}
 
+	cmd.Flags().BoolVarP(&statusWatch, "watch", "w", false, "Keep a live display that updates every second")
+
 	return cmd
 }
+
+// watchStatus redraws a single status line every second until interrupted or
+// until the entry is stopped elsewhere, in which case the final duration is shown.
+func watchStatus(db *storage.Database, entry *storage.TimeEntry) {
+	currencyCode := project.GetProjectCurrency(entry.ProjectName)
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupt)
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	render := func() {
+		fmt.Print(clearLine + formatWatchLine(entry, currencyCode))
+	}
+
+	render()
+	for {
+		select {
+		case <-interrupt:
+			fmt.Println()
+			ui.NewlineBelow()
+			return
+		case <-ticker.C:
+			current, err := getWatchedEntry(db, entry.ID)
+			if err != nil {
+				fmt.Println()
+				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
+				os.Exit(1)
+			}
+
+			if current == nil {
+				fmt.Print(clearLine)
+				ui.PrintSuccess(ui.EmojiStop, fmt.Sprintf("Tracking ended for %s (the entry was deleted)", ui.Bold(entry.ProjectName)))
+				ui.NewlineBelow()
+				return
+			}
+
+			if !current.IsRunning() {
+				fmt.Print(clearLine)
+				ui.PrintSuccess(ui.EmojiStop, fmt.Sprintf("Tracking stopped for %s", ui.Bold(current.ProjectName)))
+				ui.PrintInfo(4, ui.Bold("Total Duration"), ui.FormatDuration(current.Duration()))
+				ui.NewlineBelow()
+				return
+			}
+
+			// pick up edits made elsewhere (description, milestone, rate)
+			entry = current
+			render()
+		}
+	}
+}
+
+// getWatchedEntry reloads the entry shown by `status --watch`. It returns nil
+// if the entry was deleted from another terminal, e.g. by delete or merge.
+func getWatchedEntry(db *storage.Database, id int64) (*storage.TimeEntry, error) {
+	entry, err := db.GetEntry(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return entry, err
+}
+
+// formatWatchLine builds the compact single-line view used by `status --watch`.
+func formatWatchLine(entry *storage.TimeEntry, currencyCode string) string {
+	parts := []string{ui.Bold(entry.ProjectName)}
+
+	if entry.Description != "" {
+		parts = append(parts, entry.Description)
+	}
+
+	if entry.MilestoneName != nil && *entry.MilestoneName != "" {
+		parts = append(parts, ui.Muted(fmt.Sprintf("[%s]", *entry.MilestoneName)))
+	}
+
+	parts = append(parts, ui.Info(ui.FormatDuration(entry.Duration())))
+
+	if entry.IsBilled() {
+		parts = append(parts, ui.Success(settings.FormatCurrency(entry.Earnings(), currencyCode)))
+	}
+
+	return fmt.Sprintf("%s  %s", ui.EmojiStatus, strings.Join(parts, ui.Muted(" · ")))
+}
+
+// printRetainerBalance shows the prepaid hours left on the project's retainer,
+// including the running entry, and warns when they run low.
+func printRetainerBalance(db *storage.Database, projectName string) {
+	balance, err := retainer.Load(db, projectName)
+	if err != nil || balance == nil {
+		return
+	}
+
+	ui.PrintInfo(4, ui.Bold("Retainer"), balance.String())
+	if warning := balance.Warning(projectName); warning != "" {
+		ui.PrintWarning(ui.EmojiWarning, warning)
+	}
+}
+
+// printGoalProgress shows today's and this week's progress towards the global
+// goals and, when configured, the running project's own goals.
+func printGoalProgress(db *storage.Database, projectName string) {
+	globalCfg, err := settings.LoadGlobalConfig()
+	if err != nil {
+		return
+	}
+
+	projectDaily, projectWeekly := project.GetProjectGoals(projectName)
+
+	if globalCfg.DailyGoalHours <= 0 && globalCfg.WeeklyGoalHours <= 0 && projectDaily == nil && projectWeekly == nil {
+		return
+	}
+
+	now := time.Now().In(settings.GetDisplayTimezone())
+	dayStart := goals.StartOfDay(now)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+	weekStart := goals.StartOfWeek(now)
+	weekEnd := weekStart.AddDate(0, 0, 7)
+
+	// include the day before the week starts so sessions running past midnight are counted
+	entries, err := db.GetEntriesByDateRange(weekStart.AddDate(0, 0, -1), weekEnd)
+	if err != nil {
+		return
+	}
+
+	projectEntries := goals.FilterByProject(entries, projectName)
+
+	if globalCfg.DailyGoalHours > 0 {
+		progress := goals.NewProgress(globalCfg.DailyGoalHours, goals.TrackedBetween(entries, dayStart, dayEnd))
+		ui.PrintInfo(4, ui.Bold("Daily Goal"), progress.String())
+	}
+
+	if globalCfg.WeeklyGoalHours > 0 {
+		progress := goals.NewProgress(globalCfg.WeeklyGoalHours, goals.TrackedBetween(entries, weekStart, weekEnd))
+		ui.PrintInfo(4, ui.Bold("Weekly Goal"), progress.String())
+	}
+
+	if projectDaily != nil {
+		progress := goals.NewProgress(*projectDaily, goals.TrackedBetween(projectEntries, dayStart, dayEnd))
+		ui.PrintInfo(4, ui.Bold("Project Daily Goal"), progress.String())
+	}
+
+	if projectWeekly != nil {
+		progress := goals.NewProgress(*projectWeekly, goals.TrackedBetween(projectEntries, weekStart, weekEnd))
+		ui.PrintInfo(4, ui.Bold("Project Weekly Goal"), progress.String())
+	}
+}
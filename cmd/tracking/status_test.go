@@ -0,0 +1,85 @@
+package tracking
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatWatchLine(t *testing.T) {
+	// earnings are formatted with the global config
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("TMPO_DEV", "")
+
+	t.Run("includes description, milestone and earnings", func(t *testing.T) {
+		rate := 50.0
+		milestone := "Sprint 1"
+		entry := &storage.TimeEntry{
+			ProjectName:   "my-project",
+			StartTime:     time.Now().Add(-2 * time.Hour),
+			Description:   "Implementing feature",
+			HourlyRate:    &rate,
+			Billable:      true,
+			MilestoneName: &milestone,
+		}
+
+		line := formatWatchLine(entry, "USD")
+		assert.Contains(t, line, "my-project")
+		assert.Contains(t, line, "Implementing feature")
+		assert.Contains(t, line, "[Sprint 1]")
+		assert.Contains(t, line, "2h 0m")
+		assert.Contains(t, line, "$100.00")
+	})
+
+	t.Run("omits empty fields", func(t *testing.T) {
+		entry := &storage.TimeEntry{
+			ProjectName: "my-project",
+			StartTime:   time.Now().Add(-5 * time.Minute),
+		}
+
+		line := formatWatchLine(entry, "USD")
+		assert.Contains(t, line, "my-project")
+		assert.Contains(t, line, "5m")
+		assert.Equal(t, 1, strings.Count(line, " · "), "only project and duration should be shown")
+		assert.NotContains(t, line, "$")
+	})
+
+	t.Run("omits earnings of non-billable entries", func(t *testing.T) {
+		rate := 50.0
+		entry := &storage.TimeEntry{
+			ProjectName: "my-project",
+			StartTime:   time.Now().Add(-time.Hour),
+			HourlyRate:  &rate,
+		}
+
+		line := formatWatchLine(entry, "USD")
+		assert.NotContains(t, line, "$")
+	})
+}
+
+func TestGetWatchedEntry(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("TMPO_DEV", "")
+
+	db, err := storage.Initialize()
+	require.NoError(t, err)
+	defer db.Close()
+
+	entry, err := db.CreateEntry("web", "Parser", nil, nil, true)
+	require.NoError(t, err)
+
+	current, err := getWatchedEntry(db, entry.ID)
+	require.NoError(t, err)
+	require.NotNil(t, current)
+	assert.True(t, current.IsRunning())
+
+	require.NoError(t, db.DeleteTimeEntry(entry.ID))
+
+	current, err = getWatchedEntry(db, entry.ID)
+	require.NoError(t, err)
+	assert.Nil(t, current, "a deleted entry ends the watch")
+}
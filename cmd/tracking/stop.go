@@ -38,7 +38,7 @@ func StopCmd() *cobra.Command {
 			}
 
 			err = db.StopEntry(running.ID)
-			if(err != nil) {
+			if err != nil {
 				ui.PrintError(ui.EmojiError, fmt.Sprintf("%v", err))
 				os.Exit(1)
 			}
@@ -47,6 +47,7 @@ func StopCmd() *cobra.Command {
 
 			ui.PrintSuccess(ui.EmojiStop, fmt.Sprintf("Stopped tracking %s", ui.Bold(running.ProjectName)))
 			ui.PrintInfo(4, ui.Bold("Total Duration"), ui.FormatDuration(duration))
+			printRetainerBalance(db, running.ProjectName)
 
 			ui.NewlineBelow()
 		},
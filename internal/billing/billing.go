@@ -0,0 +1,87 @@
+package billing
+
+import "strconv"
+
+// Terms are the discount and tax applied to a project's earnings, both as
+// percentages. The discount comes off the earnings first and tax is charged
+// on what remains.
+type Terms struct {
+	Discount float64
+	TaxRate  float64
+}
+
+// IsZero reports whether the terms leave earnings unchanged.
+func (t Terms) IsZero() bool {
+	return t.Discount == 0 && t.TaxRate == 0
+}
+
+// String describes the terms, e.g. "10% discount, 19% tax".
+func (t Terms) String() string {
+	var parts string
+	if t.Discount != 0 {
+		parts = formatPercent(t.Discount) + " discount"
+	}
+	if t.TaxRate != 0 {
+		if parts != "" {
+			parts += ", "
+		}
+		parts += formatPercent(t.TaxRate) + " tax"
+	}
+	if parts == "" {
+		return "none"
+	}
+
+	return parts
+}
+
+func formatPercent(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64) + "%"
+}
+
+// Amounts splits earnings into what is charged: the subtotal of hours at
+// their rates, the discount taken off it, the net amount, the tax on the net
+// amount, and the gross amount including tax.
+type Amounts struct {
+	Subtotal float64
+	Discount float64
+	Net      float64
+	Tax      float64
+	Gross    float64
+}
+
+// Apply applies the terms to a subtotal.
+func (t Terms) Apply(subtotal float64) Amounts {
+	discount := subtotal * t.Discount / 100
+	net := subtotal - discount
+	tax := net * t.TaxRate / 100
+
+	return Amounts{
+		Subtotal: subtotal,
+		Discount: discount,
+		Net:      net,
+		Tax:      tax,
+		Gross:    net + tax,
+	}
+}
+
+// Add returns the sum of two amounts.
+func (a Amounts) Add(other Amounts) Amounts {
+	return Amounts{
+		Subtotal: a.Subtotal + other.Subtotal,
+		Discount: a.Discount + other.Discount,
+		Net:      a.Net + other.Net,
+		Tax:      a.Tax + other.Tax,
+		Gross:    a.Gross + other.Gross,
+	}
+}
+
+// Scale returns the amounts multiplied by factor, e.g. an exchange rate.
+func (a Amounts) Scale(factor float64) Amounts {
+	return Amounts{
+		Subtotal: a.Subtotal * factor,
+		Discount: a.Discount * factor,
+		Net:      a.Net * factor,
+		Tax:      a.Tax * factor,
+		Gross:    a.Gross * factor,
+	}
+}
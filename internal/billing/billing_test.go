@@ -0,0 +1,49 @@
+package billing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		terms    Terms
+		subtotal float64
+		expected Amounts
+	}{
+		{"no terms", Terms{}, 1000, Amounts{Subtotal: 1000, Net: 1000, Gross: 1000}},
+		{"tax", Terms{TaxRate: 19}, 1000, Amounts{Subtotal: 1000, Net: 1000, Tax: 190, Gross: 1190}},
+		{"discount", Terms{Discount: 10}, 1000, Amounts{Subtotal: 1000, Discount: 100, Net: 900, Gross: 900}},
+		{"tax on discounted amount", Terms{Discount: 10, TaxRate: 20}, 1000, Amounts{Subtotal: 1000, Discount: 100, Net: 900, Tax: 180, Gross: 1080}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amounts := tt.terms.Apply(tt.subtotal)
+			assert.InDelta(t, tt.expected.Subtotal, amounts.Subtotal, 0.0001)
+			assert.InDelta(t, tt.expected.Discount, amounts.Discount, 0.0001)
+			assert.InDelta(t, tt.expected.Net, amounts.Net, 0.0001)
+			assert.InDelta(t, tt.expected.Tax, amounts.Tax, 0.0001)
+			assert.InDelta(t, tt.expected.Gross, amounts.Gross, 0.0001)
+		})
+	}
+}
+
+func TestAmountsAddAndScale(t *testing.T) {
+	a := Terms{TaxRate: 10}.Apply(100)
+	b := Terms{Discount: 50}.Apply(200)
+
+	sum := a.Add(b)
+	assert.Equal(t, Amounts{Subtotal: 300, Discount: 100, Net: 200, Tax: 10, Gross: 210}, sum)
+	assert.Equal(t, Amounts{Subtotal: 600, Discount: 200, Net: 400, Tax: 20, Gross: 420}, sum.Scale(2))
+}
+
+func TestTermsString(t *testing.T) {
+	assert.Equal(t, "none", Terms{}.String())
+	assert.Equal(t, "19% tax", Terms{TaxRate: 19}.String())
+	assert.Equal(t, "12.5% discount, 7.7% tax", Terms{Discount: 12.5, TaxRate: 7.7}.String())
+	assert.True(t, Terms{}.IsZero())
+	assert.False(t, Terms{Discount: 5}.IsZero())
+}
@@ -1,87 +1,160 @@
 package currency
 
 import (
-	"fmt"
+	"math"
+	"sort"
+	"strconv"
 	"strings"
 )
 
 const DefaultCurrency = "USD"
 
-var currencySymbols = map[string]string{
-	// Americas
-	"USD": "$",   // United States Dollar
-	"CAD": "CA$", // Canadian Dollar
-	"BRL": "R$",  // Brazilian Real
-	"MXN": "MX$", // Mexican Peso
-	"ARS": "AR$", // Argentine Peso
-
-	// Europe
-	"EUR": "€",   // Euro
-	"GBP": "£",   // British Pound Sterling
-	"CHF": "Fr",  // Swiss Franc
-	"SEK": "kr",  // Swedish Krona
-	"NOK": "kr",  // Norwegian Krone
-	"DKK": "kr",  // Danish Krone
-	"PLN": "zł",  // Polish Zloty
-	"CZK": "Kč",  // Czech Koruna
-
-	// Asia
-	"JPY": "¥",   // Japanese Yen
-	"CNY": "¥",   // Chinese Yuan
-	"INR": "₹",   // Indian Rupee
-	"KRW": "₩",   // South Korean Won
-	"SGD": "S$",  // Singapore Dollar
-	"HKD": "HK$", // Hong Kong Dollar
-	"THB": "฿",   // Thai Baht
-	"IDR": "Rp",  // Indonesian Rupiah
-	"MYR": "RM",  // Malaysian Ringgit
-	"PHP": "₱",   // Philippine Peso
-	"VND": "₫",   // Vietnamese Dong
-
-	// Oceania
-	"AUD": "A$",  // Australian Dollar
-	"NZD": "NZ$", // New Zealand Dollar
-
-	// Middle East & Africa
-	"AED": "د.إ", // UAE Dirham
-	"SAR": "﷼",   // Saudi Riyal
-	"ILS": "₪",   // Israeli Shekel
-	"ZAR": "R",   // South African Rand
-	"EGP": "E£",  // Egyptian Pound
-	"TRY": "₺",   // Turkish Lira
+// Format controls how amounts are written: the separators between the whole
+// and fractional parts and between groups of thousands, and which side of
+// the amount the symbol goes on.
+type Format struct {
+	DecimalSeparator   string
+	ThousandsSeparator string // empty disables grouping
+	SymbolAfter        bool
 }
 
+// DefaultFormat writes amounts like "$1234.56".
+var DefaultFormat = Format{DecimalSeparator: "."}
+
+var localeFormats = map[string]Format{
+	"en-US": {DecimalSeparator: ".", ThousandsSeparator: ","},
+	"en-GB": {DecimalSeparator: ".", ThousandsSeparator: ","},
+	"en-IN": {DecimalSeparator: ".", ThousandsSeparator: ","},
+	"ja-JP": {DecimalSeparator: ".", ThousandsSeparator: ","},
+	"zh-CN": {DecimalSeparator: ".", ThousandsSeparator: ","},
+	"ko-KR": {DecimalSeparator: ".", ThousandsSeparator: ","},
+	"de-CH": {DecimalSeparator: ".", ThousandsSeparator: "'"},
+	"de-DE": {DecimalSeparator: ",", ThousandsSeparator: ".", SymbolAfter: true},
+	"es-ES": {DecimalSeparator: ",", ThousandsSeparator: ".", SymbolAfter: true},
+	"it-IT": {DecimalSeparator: ",", ThousandsSeparator: ".", SymbolAfter: true},
+	"nl-NL": {DecimalSeparator: ",", ThousandsSeparator: "."},
+	"pt-BR": {DecimalSeparator: ",", ThousandsSeparator: "."},
+	"fr-FR": {DecimalSeparator: ",", ThousandsSeparator: " ", SymbolAfter: true},
+	"sv-SE": {DecimalSeparator: ",", ThousandsSeparator: " ", SymbolAfter: true},
+	"pl-PL": {DecimalSeparator: ",", ThousandsSeparator: " ", SymbolAfter: true},
+	"cs-CZ": {DecimalSeparator: ",", ThousandsSeparator: " ", SymbolAfter: true},
+}
+
+// LocaleFormat returns the number format commonly used in a locale such as
+// "de-DE". Underscores and letter case are accepted, as in "de_de".
+func LocaleFormat(locale string) (Format, bool) {
+	locale = strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
+	if lang, region, found := strings.Cut(locale, "-"); found {
+		locale = strings.ToLower(lang) + "-" + strings.ToUpper(region)
+	}
+
+	format, exists := localeFormats[locale]
+	return format, exists
+}
+
+// GetLocales returns the locales with a known number format, sorted.
+func GetLocales() []string {
+	locales := make([]string, 0, len(localeFormats))
+	for locale := range localeFormats {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// FormatCurrency formats an amount in the default format.
 func FormatCurrency(amount float64, currencyCode string) string {
+	return DefaultFormat.FormatCurrency(amount, currencyCode)
+}
+
+// FormatCurrency formats an amount with the currency's symbol, rounded to its
+// minor units, e.g. "1.234,56 €" or "¥10,000". Unsupported currency codes
+// fall back to the default currency.
+func (f Format) FormatCurrency(amount float64, currencyCode string) string {
 	currencyCode = strings.ToUpper(strings.TrimSpace(currencyCode))
 
 	if currencyCode == "" || !IsSupported(currencyCode) {
 		currencyCode = DefaultCurrency
 	}
 
+	number := f.FormatNumber(amount, MinorUnits(currencyCode))
 	symbol := GetSymbol(currencyCode)
-	return fmt.Sprintf("%s%.2f", symbol, amount)
+
+	if f.SymbolAfter {
+		return number + " " + symbol
+	}
+
+	// codes written in place of a symbol need a space: "KES 100.00"
+	if symbol == currencyCode {
+		return symbol + " " + number
+	}
+
+	return symbol + number
+}
+
+// FormatNumber formats an amount with the given number of decimals using the
+// format's separators.
+func (f Format) FormatNumber(amount float64, decimals int) string {
+	decimalSeparator := f.DecimalSeparator
+	if decimalSeparator == "" {
+		decimalSeparator = "."
+	}
+
+	formatted := strconv.FormatFloat(math.Abs(amount), 'f', decimals, 64)
+	whole, fraction, _ := strings.Cut(formatted, ".")
+
+	var b strings.Builder
+	if amount < 0 && strings.Trim(formatted, "0.") != "" {
+		b.WriteString("-")
+	}
+
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteString(f.ThousandsSeparator)
+		}
+		b.WriteRune(digit)
+	}
+
+	if fraction != "" {
+		b.WriteString(decimalSeparator)
+		b.WriteString(fraction)
+	}
+
+	return b.String()
 }
 
 func GetSymbol(currencyCode string) string {
 	currencyCode = strings.ToUpper(strings.TrimSpace(currencyCode))
 
-	if symbol, exists := currencySymbols[currencyCode]; exists {
-		return symbol
+	if info, exists := currencies[currencyCode]; exists && info.symbol != "" {
+		return info.symbol
 	}
 
 	return currencyCode
 }
 
+// MinorUnits returns how many decimals amounts in the currency are written
+// with, e.g. 0 for JPY and 3 for KWD. Unknown currencies use 2.
+func MinorUnits(currencyCode string) int {
+	currencyCode = strings.ToUpper(strings.TrimSpace(currencyCode))
+
+	if info, exists := currencies[currencyCode]; exists {
+		return info.minorUnits
+	}
+
+	return 2
+}
+
 func IsSupported(currencyCode string) bool {
 	currencyCode = strings.ToUpper(strings.TrimSpace(currencyCode))
-	_, exists := currencySymbols[currencyCode]
+	_, exists := currencies[currencyCode]
 	return exists
 }
 
 func GetSupportedCurrencies() []string {
-	currencies := make([]string, 0, len(currencySymbols))
-	for code := range currencySymbols {
-		currencies = append(currencies, code)
+	codes := make([]string, 0, len(currencies))
+	for code := range currencies {
+		codes = append(codes, code)
 	}
-	return currencies
+	return codes
 }
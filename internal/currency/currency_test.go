@@ -61,10 +61,10 @@ func TestFormatCurrency(t *testing.T) {
 
 		// Asian currencies
 		{
-			name:         "JPY with standard amount",
+			name:         "JPY has no minor units",
 			amount:       10000.00,
 			currencyCode: "JPY",
-			expected:     "¥10000.00",
+			expected:     "¥10000",
 		},
 		{
 			name:         "INR with standard amount",
@@ -73,10 +73,10 @@ func TestFormatCurrency(t *testing.T) {
 			expected:     "₹5000.00",
 		},
 		{
-			name:         "KRW with standard amount",
+			name:         "KRW has no minor units",
 			amount:       100000.00,
 			currencyCode: "KRW",
-			expected:     "₩100000.00",
+			expected:     "₩100000",
 		},
 
 		// Other currencies
@@ -92,6 +92,18 @@ func TestFormatCurrency(t *testing.T) {
 			currencyCode: "AUD",
 			expected:     "A$150.00",
 		},
+		{
+			name:         "KWD has three minor units",
+			amount:       12.3456,
+			currencyCode: "KWD",
+			expected:     "KWD 12.346",
+		},
+		{
+			name:         "Currency without a symbol uses its code",
+			amount:       100.00,
+			currencyCode: "KES",
+			expected:     "KES 100.00",
+		},
 		{
 			name:         "CHF with standard amount",
 			amount:       100.00,
@@ -149,6 +161,82 @@ func TestFormatCurrency(t *testing.T) {
 	}
 }
 
+func TestFormatCurrencyWithFormat(t *testing.T) {
+	german, _ := LocaleFormat("de-DE")
+	french, _ := LocaleFormat("fr-FR")
+	american, _ := LocaleFormat("en-US")
+	swiss, _ := LocaleFormat("de-CH")
+
+	tests := []struct {
+		name         string
+		format       Format
+		amount       float64
+		currencyCode string
+		expected     string
+	}{
+		{"German euros", german, 1234.56, "EUR", "1.234,56 €"},
+		{"French euros", french, 1234567.891, "EUR", "1 234 567,89 €"},
+		{"American dollars", american, 1234567.891, "USD", "$1,234,567.89"},
+		{"Swiss francs", swiss, 9876.5, "CHF", "Fr9'876.50"},
+		{"Yen without minor units", american, 1234567.4, "JPY", "¥1,234,567"},
+		{"Yen with the symbol after", german, 1500, "JPY", "1.500 ¥"},
+		{"Short amount is not grouped", american, 999.99, "USD", "$999.99"},
+		{"Negative amount", german, -1234.5, "EUR", "-1.234,50 €"},
+		{"Negative amount rounding to zero", american, -0.001, "USD", "$0.00"},
+		{"Code after the amount", american, 1000, "KES", "KES 1,000.00"},
+		{"Custom format", Format{DecimalSeparator: ",", ThousandsSeparator: "."}, 1234.5, "BRL", "R$1.234,50"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.format.FormatCurrency(tt.amount, tt.currencyCode)
+			if result != tt.expected {
+				t.Errorf("FormatCurrency(%f, %q) = %q, expected %q",
+					tt.amount, tt.currencyCode, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocaleFormat(t *testing.T) {
+	format, ok := LocaleFormat("de_de")
+	if !ok {
+		t.Fatalf("LocaleFormat(%q) not found", "de_de")
+	}
+	if format.DecimalSeparator != "," || format.ThousandsSeparator != "." || !format.SymbolAfter {
+		t.Errorf("LocaleFormat(%q) = %+v, expected German separators", "de_de", format)
+	}
+
+	if _, ok := LocaleFormat("xx-XX"); ok {
+		t.Errorf("LocaleFormat(%q) should not be found", "xx-XX")
+	}
+
+	for _, locale := range GetLocales() {
+		if _, ok := LocaleFormat(locale); !ok {
+			t.Errorf("GetLocales() returned unknown locale %q", locale)
+		}
+	}
+}
+
+func TestMinorUnits(t *testing.T) {
+	tests := map[string]int{
+		"USD": 2,
+		"eur": 2,
+		"JPY": 0,
+		"KRW": 0,
+		"VND": 0,
+		"KWD": 3,
+		"CLF": 4,
+		"XYZ": 2,
+	}
+
+	for code, expected := range tests {
+		if result := MinorUnits(code); result != expected {
+			t.Errorf("MinorUnits(%q) = %d, expected %d", code, result, expected)
+		}
+	}
+}
+
 func TestGetSymbol(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -0,0 +1,189 @@
+package currency
+
+// currencyInfo describes an ISO 4217 currency.
+type currencyInfo struct {
+	symbol     string // empty when the currency is written with its code
+	minorUnits int    // digits after the decimal separator
+}
+
+// currencies holds every active ISO 4217 currency code. Symbols are only set
+// where they are widely recognized; other currencies are written with their
+// code, e.g. "KES 100.00".
+var currencies = map[string]currencyInfo{
+	// Americas
+	"USD": {"$", 2},    // United States Dollar
+	"CAD": {"CA$", 2},  // Canadian Dollar
+	"BRL": {"R$", 2},   // Brazilian Real
+	"MXN": {"MX$", 2},  // Mexican Peso
+	"ARS": {"AR$", 2},  // Argentine Peso
+	"AWG": {"", 2},     // Aruban Florin
+	"BBD": {"", 2},     // Barbados Dollar
+	"BMD": {"", 2},     // Bermudian Dollar
+	"BOB": {"Bs", 2},   // Boliviano
+	"BOV": {"", 2},     // Bolivian Mvdol
+	"BSD": {"", 2},     // Bahamian Dollar
+	"BZD": {"", 2},     // Belize Dollar
+	"CLF": {"", 4},     // Unidad de Fomento
+	"CLP": {"CL$", 0},  // Chilean Peso
+	"COP": {"CO$", 2},  // Colombian Peso
+	"COU": {"", 2},     // Unidad de Valor Real
+	"CRC": {"₡", 2},    // Costa Rican Colon
+	"CUC": {"", 2},     // Cuban Convertible Peso
+	"CUP": {"", 2},     // Cuban Peso
+	"DOP": {"RD$", 2},  // Dominican Peso
+	"FKP": {"", 2},     // Falkland Islands Pound
+	"GTQ": {"Q", 2},    // Guatemalan Quetzal
+	"GYD": {"", 2},     // Guyana Dollar
+	"HNL": {"L", 2},    // Honduran Lempira
+	"HTG": {"", 2},     // Haitian Gourde
+	"JMD": {"J$", 2},   // Jamaican Dollar
+	"KYD": {"", 2},     // Cayman Islands Dollar
+	"MXV": {"", 2},     // Mexican Unidad de Inversion
+	"NIO": {"C$", 2},   // Nicaraguan Cordoba Oro
+	"PAB": {"B/.", 2},  // Panamanian Balboa
+	"PEN": {"S/", 2},   // Peruvian Sol
+	"PYG": {"₲", 0},    // Paraguayan Guarani
+	"SRD": {"", 2},     // Surinam Dollar
+	"SVC": {"", 2},     // El Salvador Colon
+	"TTD": {"TT$", 2},  // Trinidad and Tobago Dollar
+	"USN": {"", 2},     // US Dollar (Next day)
+	"UYI": {"", 0},     // Uruguay Peso en Unidades Indexadas
+	"UYU": {"$U", 2},   // Peso Uruguayo
+	"UYW": {"", 4},     // Unidad Previsional
+	"VED": {"", 2},     // Bolivar Digital
+	"VES": {"Bs.S", 2}, // Bolivar Soberano
+	"XCD": {"EC$", 2},  // East Caribbean Dollar
+	"XCG": {"", 2},     // Caribbean Guilder
+	"ANG": {"", 2},     // Netherlands Antillean Guilder
+
+	// Europe
+	"EUR": {"€", 2},   // Euro
+	"GBP": {"£", 2},   // British Pound Sterling
+	"CHF": {"Fr", 2},  // Swiss Franc
+	"SEK": {"kr", 2},  // Swedish Krona
+	"NOK": {"kr", 2},  // Norwegian Krone
+	"DKK": {"kr", 2},  // Danish Krone
+	"PLN": {"zł", 2},  // Polish Zloty
+	"CZK": {"Kč", 2},  // Czech Koruna
+	"ALL": {"", 2},    // Albanian Lek
+	"BAM": {"KM", 2},  // Convertible Mark
+	"BGN": {"лв", 2},  // Bulgarian Lev
+	"BYN": {"Br", 2},  // Belarusian Ruble
+	"CHE": {"", 2},    // WIR Euro
+	"CHW": {"", 2},    // WIR Franc
+	"GIP": {"", 2},    // Gibraltar Pound
+	"HUF": {"Ft", 2},  // Hungarian Forint
+	"ISK": {"kr", 0},  // Iceland Krona
+	"MDL": {"", 2},    // Moldovan Leu
+	"MKD": {"ден", 2}, // Macedonian Denar
+	"RON": {"lei", 2}, // Romanian Leu
+	"RSD": {"", 2},    // Serbian Dinar
+	"RUB": {"₽", 2},   // Russian Ruble
+	"UAH": {"₴", 2},   // Ukrainian Hryvnia
+
+	// Asia
+	"JPY": {"¥", 0},    // Japanese Yen
+	"CNY": {"¥", 2},    // Chinese Yuan
+	"INR": {"₹", 2},    // Indian Rupee
+	"KRW": {"₩", 0},    // South Korean Won
+	"SGD": {"S$", 2},   // Singapore Dollar
+	"HKD": {"HK$", 2},  // Hong Kong Dollar
+	"THB": {"฿", 2},    // Thai Baht
+	"IDR": {"Rp", 2},   // Indonesian Rupiah
+	"MYR": {"RM", 2},   // Malaysian Ringgit
+	"PHP": {"₱", 2},    // Philippine Peso
+	"VND": {"₫", 0},    // Vietnamese Dong
+	"AFN": {"؋", 2},    // Afghan Afghani
+	"AMD": {"֏", 2},    // Armenian Dram
+	"AZN": {"₼", 2},    // Azerbaijan Manat
+	"BDT": {"৳", 2},    // Bangladeshi Taka
+	"BND": {"B$", 2},   // Brunei Dollar
+	"BTN": {"", 2},     // Bhutanese Ngultrum
+	"GEL": {"₾", 2},    // Georgian Lari
+	"KGS": {"", 2},     // Kyrgyzstani Som
+	"KHR": {"៛", 2},    // Cambodian Riel
+	"KPW": {"", 2},     // North Korean Won
+	"KZT": {"₸", 2},    // Kazakhstani Tenge
+	"LAK": {"₭", 2},    // Lao Kip
+	"LKR": {"Rs", 2},   // Sri Lanka Rupee
+	"MMK": {"K", 2},    // Myanmar Kyat
+	"MNT": {"₮", 2},    // Mongolian Tugrik
+	"MOP": {"MOP$", 2}, // Macanese Pataca
+	"MVR": {"Rf", 2},   // Maldivian Rufiyaa
+	"NPR": {"Rs", 2},   // Nepalese Rupee
+	"PKR": {"Rs", 2},   // Pakistan Rupee
+	"TJS": {"", 2},     // Tajikistani Somoni
+	"TMT": {"", 2},     // Turkmenistan New Manat
+	"TWD": {"NT$", 2},  // New Taiwan Dollar
+	"UZS": {"", 2},     // Uzbekistan Sum
+
+	// Oceania
+	"AUD": {"A$", 2},  // Australian Dollar
+	"NZD": {"NZ$", 2}, // New Zealand Dollar
+	"FJD": {"FJ$", 2}, // Fiji Dollar
+	"PGK": {"K", 2},   // Papua New Guinean Kina
+	"SBD": {"SI$", 2}, // Solomon Islands Dollar
+	"TOP": {"T$", 2},  // Tongan Pa'anga
+	"VUV": {"VT", 0},  // Vanuatu Vatu
+	"WST": {"WS$", 2}, // Samoan Tala
+	"XPF": {"₣", 0},   // CFP Franc
+
+	// Middle East & Africa
+	"AED": {"د.إ", 2},  // UAE Dirham
+	"SAR": {"﷼", 2},    // Saudi Riyal
+	"ILS": {"₪", 2},    // Israeli Shekel
+	"ZAR": {"R", 2},    // South African Rand
+	"EGP": {"E£", 2},   // Egyptian Pound
+	"TRY": {"₺", 2},    // Turkish Lira
+	"AOA": {"Kz", 2},   // Angolan Kwanza
+	"BHD": {"", 3},     // Bahraini Dinar
+	"BIF": {"", 0},     // Burundi Franc
+	"BWP": {"P", 2},    // Botswana Pula
+	"CDF": {"", 2},     // Congolese Franc
+	"CVE": {"", 2},     // Cabo Verde Escudo
+	"DJF": {"", 0},     // Djibouti Franc
+	"DZD": {"", 2},     // Algerian Dinar
+	"ERN": {"", 2},     // Eritrean Nakfa
+	"ETB": {"Br", 2},   // Ethiopian Birr
+	"GHS": {"₵", 2},    // Ghana Cedi
+	"GMD": {"D", 2},    // Gambian Dalasi
+	"GNF": {"", 0},     // Guinean Franc
+	"IQD": {"", 3},     // Iraqi Dinar
+	"IRR": {"", 2},     // Iranian Rial
+	"JOD": {"", 3},     // Jordanian Dinar
+	"KES": {"", 2},     // Kenyan Shilling
+	"KMF": {"", 0},     // Comorian Franc
+	"KWD": {"", 3},     // Kuwaiti Dinar
+	"LBP": {"", 2},     // Lebanese Pound
+	"LRD": {"", 2},     // Liberian Dollar
+	"LSL": {"", 2},     // Lesotho Loti
+	"LYD": {"", 3},     // Libyan Dinar
+	"MAD": {"", 2},     // Moroccan Dirham
+	"MGA": {"Ar", 2},   // Malagasy Ariary
+	"MRU": {"", 2},     // Mauritanian Ouguiya
+	"MUR": {"Rs", 2},   // Mauritius Rupee
+	"MWK": {"MK", 2},   // Malawi Kwacha
+	"MZN": {"MT", 2},   // Mozambique Metical
+	"NAD": {"N$", 2},   // Namibia Dollar
+	"NGN": {"₦", 2},    // Nigerian Naira
+	"OMR": {"", 3},     // Rial Omani
+	"QAR": {"", 2},     // Qatari Rial
+	"RWF": {"", 0},     // Rwanda Franc
+	"SCR": {"", 2},     // Seychelles Rupee
+	"SDG": {"", 2},     // Sudanese Pound
+	"SHP": {"", 2},     // Saint Helena Pound
+	"SLE": {"", 2},     // Sierra Leonean Leone
+	"SOS": {"", 2},     // Somali Shilling
+	"SSP": {"", 2},     // South Sudanese Pound
+	"STN": {"Db", 2},   // Sao Tome and Principe Dobra
+	"SYP": {"", 2},     // Syrian Pound
+	"SZL": {"", 2},     // Swazi Lilangeni
+	"TND": {"", 3},     // Tunisian Dinar
+	"TZS": {"", 2},     // Tanzanian Shilling
+	"UGX": {"", 0},     // Uganda Shilling
+	"XAF": {"FCFA", 0}, // CFA Franc BEAC
+	"XOF": {"CFA", 0},  // CFA Franc BCEAO
+	"YER": {"", 2},     // Yemeni Rial
+	"ZMW": {"ZK", 2},   // Zambian Kwacha
+	"ZWG": {"ZiG", 2},  // Zimbabwe Gold
+}
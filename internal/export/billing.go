@@ -0,0 +1,56 @@
+package export
+
+import (
+	"strconv"
+
+	"github.com/DylanDevelops/tmpo/internal/billing"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+)
+
+// Billing looks up the currency and the discount and tax terms of a project.
+// A nil Billing leaves the billing columns of an export empty.
+type Billing func(projectName string) (currencyCode string, terms billing.Terms)
+
+type ExportBilling struct {
+	Currency   string  `json:"currency"`
+	HourlyRate float64 `json:"hourly_rate"`
+	Earnings   float64 `json:"earnings"`
+	Discount   float64 `json:"discount"`
+	Net        float64 `json:"net"`
+	Tax        float64 `json:"tax"`
+	Gross      float64 `json:"gross"`
+}
+
+// NewExportBilling returns the billed amounts of an entry, or nil when the
+// entry isn't billed.
+func NewExportBilling(entry *storage.TimeEntry, lookup Billing) *ExportBilling {
+	if lookup == nil || !entry.IsBilled() {
+		return nil
+	}
+
+	currencyCode, terms := lookup(entry.ProjectName)
+	amounts := terms.Apply(entry.Earnings())
+
+	return &ExportBilling{
+		Currency:   currencyCode,
+		HourlyRate: *entry.HourlyRate,
+		Earnings:   amounts.Subtotal,
+		Discount:   amounts.Discount,
+		Net:        amounts.Net,
+		Tax:        amounts.Tax,
+		Gross:      amounts.Gross,
+	}
+}
+
+// csvRecord returns the billing columns of a CSV row.
+func (b *ExportBilling) csvRecord() []string {
+	if b == nil {
+		return make([]string, 7)
+	}
+
+	amount := func(value float64) string {
+		return strconv.FormatFloat(value, 'f', 2, 64)
+	}
+
+	return []string{b.Currency, amount(b.HourlyRate), amount(b.Earnings), amount(b.Discount), amount(b.Net), amount(b.Tax), amount(b.Gross)}
+}
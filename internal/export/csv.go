@@ -4,11 +4,13 @@ import (
 	"encoding/csv"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/DylanDevelops/tmpo/internal/storage"
 )
 
-func ToCSV(entries []*storage.TimeEntry, filename string) error {
+func ToCSV(entries []*storage.TimeEntry, filename string, lookup Billing) error {
 	file, err := os.Create(filename)
 	if err != nil {
 		return fmt.Errorf("failed to create CSV file: %w", err)
@@ -20,7 +22,7 @@ func ToCSV(entries []*storage.TimeEntry, filename string) error {
 
 	defer writer.Flush()
 
-	header := []string{"Project", "Start Time", "End Time", "Duration (hours)", "Description", "Milestone"}
+	header := []string{"Project", "Start Time", "End Time", "Duration (hours)", "Description", "Milestone", "Issue", "Commits", "Notes", "Billable", "Currency", "Hourly Rate", "Earnings", "Discount", "Net", "Tax", "Gross"}
 	if err := writer.Write(header); err != nil {
 		return fmt.Errorf("failed to write header: %w", err)
 	}
@@ -36,6 +38,11 @@ func ToCSV(entries []*storage.TimeEntry, filename string) error {
 			milestoneName = *entry.MilestoneName
 		}
 
+		var commits []string
+		for _, commit := range entry.Commits {
+			commits = append(commits, fmt.Sprintf("%s %s", commit.ShortSHA(), commit.Message))
+		}
+
 		duration := entry.Duration().Hours()
 
 		record := []string{
@@ -45,7 +52,12 @@ func ToCSV(entries []*storage.TimeEntry, filename string) error {
 			fmt.Sprintf("%.2f", duration),
 			entry.Description,
 			milestoneName,
+			entry.IssueKey,
+			strings.Join(commits, "; "),
+			entry.Notes,
+			strconv.FormatBool(entry.Billable),
 		}
+		record = append(record, NewExportBilling(entry, lookup).csvRecord()...)
 
 		if err := writer.Write(record); err != nil {
 			return fmt.Errorf("failed to write record: %w", err)
@@ -53,4 +65,4 @@ func ToCSV(entries []*storage.TimeEntry, filename string) error {
 	}
 
 	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,94 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+)
+
+type ExportExpense struct {
+	Project     string  `json:"project"`
+	Date        string  `json:"date"`
+	Amount      float64 `json:"amount"`
+	Currency    string  `json:"currency"`
+	Category    string  `json:"category,omitempty"`
+	Description string  `json:"description,omitempty"`
+	ReceiptPath string  `json:"receipt_path,omitempty"`
+}
+
+// NewExportExpense converts an expense to its exported JSON form.
+func NewExportExpense(expense *storage.Expense) ExportExpense {
+	return ExportExpense{
+		Project:     expense.ProjectName,
+		Date:        expense.Date.Format("2006-01-02T15:04:05Z07:00"),
+		Amount:      expense.Amount,
+		Currency:    expense.Currency,
+		Category:    expense.Category,
+		Description: expense.Description,
+		ReceiptPath: expense.ReceiptPath,
+	}
+}
+
+func ExpensesToCSV(expenses []*storage.Expense, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+
+	defer file.Close()
+
+	writer := csv.NewWriter(file)
+
+	defer writer.Flush()
+
+	header := []string{"Project", "Date", "Amount", "Currency", "Category", "Description", "Receipt"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	for _, expense := range expenses {
+		record := []string{
+			expense.ProjectName,
+			expense.Date.Format("2006-01-02 15:04:05"),
+			strconv.FormatFloat(expense.Amount, 'f', 2, 64),
+			expense.Currency,
+			expense.Category,
+			expense.Description,
+			expense.ReceiptPath,
+		}
+
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write record: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func ExpensesToJson(expenses []*storage.Expense, filename string) error {
+	var exportExpenses []ExportExpense
+
+	for _, expense := range expenses {
+		exportExpenses = append(exportExpenses, NewExportExpense(expense))
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create JSON file: %w", err)
+	}
+
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(exportExpenses); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/DylanDevelops/tmpo/internal/billing"
 	"github.com/DylanDevelops/tmpo/internal/storage"
 	"github.com/stretchr/testify/assert"
 )
@@ -26,6 +27,8 @@ func TestToCSV(t *testing.T) {
 				StartTime:   startTime,
 				EndTime:     &endTime,
 				Description: "Test work",
+				Notes:       "Set up the project.\nWrote the first tests.",
+				Billable:    true,
 			},
 			{
 				ID:          2,
@@ -37,7 +40,7 @@ func TestToCSV(t *testing.T) {
 		}
 
 		filename := filepath.Join(tmpDir, "test.csv")
-		err := ToCSV(entries, filename)
+		err := ToCSV(entries, filename, nil)
 		assert.NoError(t, err)
 
 		// Verify file exists
@@ -57,7 +60,7 @@ func TestToCSV(t *testing.T) {
 		assert.Len(t, records, 3)
 
 		// Verify header
-		assert.Equal(t, []string{"Project", "Start Time", "End Time", "Duration (hours)", "Description", "Milestone"}, records[0])
+		assert.Equal(t, []string{"Project", "Start Time", "End Time", "Duration (hours)", "Description", "Milestone", "Issue", "Commits", "Notes", "Billable", "Currency", "Hourly Rate", "Earnings", "Discount", "Net", "Tax", "Gross"}, records[0])
 
 		// Verify first entry
 		assert.Equal(t, "test-project", records[1][0])
@@ -66,6 +69,10 @@ func TestToCSV(t *testing.T) {
 		assert.Equal(t, "8.00", records[1][3]) // 8 hours
 		assert.Equal(t, "Test work", records[1][4])
 		assert.Equal(t, "", records[1][5]) // No milestone
+		assert.Equal(t, "Set up the project.\nWrote the first tests.", records[1][8])
+		assert.Equal(t, "", records[2][8]) // No notes
+		assert.Equal(t, "true", records[1][9])
+		assert.Equal(t, "false", records[2][9])
 	})
 
 	t.Run("handles running entries", func(t *testing.T) {
@@ -82,7 +89,7 @@ func TestToCSV(t *testing.T) {
 		}
 
 		filename := filepath.Join(tmpDir, "running.csv")
-		err := ToCSV(entries, filename)
+		err := ToCSV(entries, filename, nil)
 		assert.NoError(t, err)
 
 		// Read CSV
@@ -102,7 +109,7 @@ func TestToCSV(t *testing.T) {
 		entries := []*storage.TimeEntry{}
 
 		filename := filepath.Join(tmpDir, "empty.csv")
-		err := ToCSV(entries, filename)
+		err := ToCSV(entries, filename, nil)
 		assert.NoError(t, err)
 
 		// Read CSV
@@ -133,7 +140,7 @@ func TestToCSV(t *testing.T) {
 		}
 
 		filename := filepath.Join(tmpDir, "no-desc.csv")
-		err := ToCSV(entries, filename)
+		err := ToCSV(entries, filename, nil)
 		assert.NoError(t, err)
 
 		// Read CSV
@@ -148,6 +155,35 @@ func TestToCSV(t *testing.T) {
 		// Description should be empty string
 		assert.Empty(t, records[1][4])
 	})
+	t.Run("includes linked commits", func(t *testing.T) {
+		startTime := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+		endTime := time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)
+
+		entries := []*storage.TimeEntry{
+			{
+				ID:          1,
+				ProjectName: "test",
+				StartTime:   startTime,
+				EndTime:     &endTime,
+				Commits: []*storage.EntryCommit{
+					{SHA: "abcdef1234567890", Message: "Add login form", CommittedAt: startTime.Add(time.Hour)},
+					{SHA: "1234567abcdef890", Message: "Fix validation", CommittedAt: endTime},
+				},
+			},
+		}
+
+		filename := filepath.Join(tmpDir, "commits.csv")
+		err := ToCSV(entries, filename, nil)
+		assert.NoError(t, err)
+
+		file, err := os.Open(filename)
+		assert.NoError(t, err)
+		defer file.Close()
+
+		records, err := csv.NewReader(file).ReadAll()
+		assert.NoError(t, err)
+		assert.Equal(t, "abcdef1 Add login form; 1234567 Fix validation", records[1][7])
+	})
 }
 
 func TestToJson(t *testing.T) {
@@ -164,6 +200,8 @@ func TestToJson(t *testing.T) {
 				StartTime:   startTime,
 				EndTime:     &endTime,
 				Description: "Test work",
+				Notes:       "Set up the project.\nWrote the first tests.",
+				Billable:    true,
 			},
 			{
 				ID:          2,
@@ -175,7 +213,7 @@ func TestToJson(t *testing.T) {
 		}
 
 		filename := filepath.Join(tmpDir, "test.json")
-		err := ToJson(entries, filename)
+		err := ToJson(entries, filename, nil)
 		assert.NoError(t, err)
 
 		// Verify file exists
@@ -201,6 +239,10 @@ func TestToJson(t *testing.T) {
 		assert.Equal(t, "2024-01-01T17:00:00Z", exportedEntries[0].EndTime)
 		assert.Equal(t, 8.0, exportedEntries[0].Duration)
 		assert.Equal(t, "Test work", exportedEntries[0].Description)
+		assert.Equal(t, "Set up the project.\nWrote the first tests.", exportedEntries[0].Notes)
+		assert.Empty(t, exportedEntries[1].Notes)
+		assert.True(t, exportedEntries[0].Billable)
+		assert.False(t, exportedEntries[1].Billable)
 	})
 
 	t.Run("handles running entries", func(t *testing.T) {
@@ -217,7 +259,7 @@ func TestToJson(t *testing.T) {
 		}
 
 		filename := filepath.Join(tmpDir, "running.json")
-		err := ToJson(entries, filename)
+		err := ToJson(entries, filename, nil)
 		assert.NoError(t, err)
 
 		// Read JSON
@@ -238,7 +280,7 @@ func TestToJson(t *testing.T) {
 		entries := []*storage.TimeEntry{}
 
 		filename := filepath.Join(tmpDir, "empty.json")
-		err := ToJson(entries, filename)
+		err := ToJson(entries, filename, nil)
 		assert.NoError(t, err)
 
 		// Read JSON
@@ -270,7 +312,7 @@ func TestToJson(t *testing.T) {
 		}
 
 		filename := filepath.Join(tmpDir, "no-desc.json")
-		err := ToJson(entries, filename)
+		err := ToJson(entries, filename, nil)
 		assert.NoError(t, err)
 
 		// Read raw JSON to verify omission
@@ -288,4 +330,152 @@ func TestToJson(t *testing.T) {
 			assert.Empty(t, desc)
 		}
 	})
+
+	t.Run("includes linked commits", func(t *testing.T) {
+		startTime := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+		endTime := time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)
+
+		entries := []*storage.TimeEntry{
+			{
+				ID:          1,
+				ProjectName: "test",
+				StartTime:   startTime,
+				EndTime:     &endTime,
+				Commits: []*storage.EntryCommit{
+					{SHA: "abcdef1234567890", Message: "Add login form", CommittedAt: startTime.Add(time.Hour)},
+				},
+			},
+		}
+
+		filename := filepath.Join(tmpDir, "commits.json")
+		err := ToJson(entries, filename, nil)
+		assert.NoError(t, err)
+
+		content, err := os.ReadFile(filename)
+		assert.NoError(t, err)
+
+		var exported []ExportEntry
+		err = json.Unmarshal(content, &exported)
+		assert.NoError(t, err)
+		assert.Len(t, exported[0].Commits, 1)
+		assert.Equal(t, "abcdef1234567890", exported[0].Commits[0].SHA)
+		assert.Equal(t, "Add login form", exported[0].Commits[0].Message)
+		assert.Equal(t, "2024-01-01T10:00:00Z", exported[0].Commits[0].CommittedAt)
+	})
+}
+
+func TestExportBilling(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	startTime := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	endTime := time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC)
+	rate := 100.0
+
+	entries := []*storage.TimeEntry{
+		{ProjectName: "client", StartTime: startTime, EndTime: &endTime, HourlyRate: &rate, Billable: true},
+		{ProjectName: "client", StartTime: startTime, EndTime: &endTime, HourlyRate: &rate},
+	}
+
+	lookup := func(projectName string) (string, billing.Terms) {
+		return "EUR", billing.Terms{Discount: 10, TaxRate: 20}
+	}
+
+	t.Run("adds billing columns to CSV", func(t *testing.T) {
+		filename := filepath.Join(tmpDir, "billing.csv")
+		assert.NoError(t, ToCSV(entries, filename, lookup))
+
+		file, err := os.Open(filename)
+		assert.NoError(t, err)
+		defer file.Close()
+
+		records, err := csv.NewReader(file).ReadAll()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"EUR", "100.00", "200.00", "20.00", "180.00", "36.00", "216.00"}, records[1][10:])
+		assert.Equal(t, []string{"", "", "", "", "", "", ""}, records[2][10:])
+	})
+
+	t.Run("adds billing to JSON", func(t *testing.T) {
+		filename := filepath.Join(tmpDir, "billing.json")
+		assert.NoError(t, ToJson(entries, filename, lookup))
+
+		content, err := os.ReadFile(filename)
+		assert.NoError(t, err)
+
+		var exported []ExportEntry
+		assert.NoError(t, json.Unmarshal(content, &exported))
+		assert.Equal(t, &ExportBilling{Currency: "EUR", HourlyRate: 100, Earnings: 200, Discount: 20, Net: 180, Tax: 36, Gross: 216}, exported[0].Billing)
+		assert.Nil(t, exported[1].Billing)
+	})
+}
+
+func TestExpensesToCSV(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	expenses := []*storage.Expense{
+		{
+			ID:          1,
+			ProjectName: "test-project",
+			Date:        time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+			Amount:      320,
+			Currency:    "EUR",
+			Category:    "travel",
+			Description: "Train to Berlin",
+			ReceiptPath: "/receipts/train.pdf",
+		},
+		{
+			ID:          2,
+			ProjectName: "test-project",
+			Date:        time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC),
+			Amount:      49.9,
+			Currency:    "USD",
+		},
+	}
+
+	filename := filepath.Join(tmpDir, "expenses.csv")
+	assert.NoError(t, ExpensesToCSV(expenses, filename))
+
+	file, err := os.Open(filename)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 3)
+
+	assert.Equal(t, []string{"Project", "Date", "Amount", "Currency", "Category", "Description", "Receipt"}, records[0])
+	assert.Equal(t, []string{"test-project", "2024-01-10 00:00:00", "320.00", "EUR", "travel", "Train to Berlin", "/receipts/train.pdf"}, records[1])
+	assert.Equal(t, "49.90", records[2][2])
+	assert.Equal(t, "", records[2][4])
+}
+
+func TestExpensesToJson(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	expenses := []*storage.Expense{
+		{
+			ID:          1,
+			ProjectName: "test-project",
+			Date:        time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+			Amount:      320,
+			Currency:    "EUR",
+			Category:    "travel",
+		},
+	}
+
+	filename := filepath.Join(tmpDir, "expenses.json")
+	assert.NoError(t, ExpensesToJson(expenses, filename))
+
+	file, err := os.Open(filename)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	var exported []ExportExpense
+	assert.NoError(t, json.NewDecoder(file).Decode(&exported))
+	assert.Len(t, exported, 1)
+	assert.Equal(t, "test-project", exported[0].Project)
+	assert.Equal(t, "2024-01-10T00:00:00Z", exported[0].Date)
+	assert.Equal(t, 320.0, exported[0].Amount)
+	assert.Equal(t, "EUR", exported[0].Currency)
+	assert.Equal(t, "travel", exported[0].Category)
+	assert.Empty(t, exported[0].ReceiptPath)
 }
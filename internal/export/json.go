@@ -9,34 +9,63 @@ import (
 )
 
 type ExportEntry struct {
-	Project     string  `json:"project"`
-	StartTime   string  `json:"start_time"`
-	EndTime     string  `json:"end_time,omitempty"`
-	Duration    float64 `json:"duration_hours"`
-	Description string  `json:"description,omitempty"`
-	Milestone   string  `json:"milestone,omitempty"`
+	Project     string         `json:"project"`
+	StartTime   string         `json:"start_time"`
+	EndTime     string         `json:"end_time,omitempty"`
+	Duration    float64        `json:"duration_hours"`
+	Description string         `json:"description,omitempty"`
+	Milestone   string         `json:"milestone,omitempty"`
+	IssueKey    string         `json:"issue_key,omitempty"`
+	Notes       string         `json:"notes,omitempty"`
+	Billable    bool           `json:"billable"`
+	Commits     []ExportCommit `json:"commits,omitempty"`
+	Billing     *ExportBilling `json:"billing,omitempty"`
 }
 
-func ToJson(entries []*storage.TimeEntry, filename string) error {
+type ExportCommit struct {
+	SHA         string `json:"sha"`
+	Message     string `json:"message"`
+	CommittedAt string `json:"committed_at"`
+}
+
+// NewExportEntry converts an entry to its exported JSON form.
+func NewExportEntry(entry *storage.TimeEntry) ExportEntry {
+	export := ExportEntry{
+		Project:     entry.ProjectName,
+		StartTime:   entry.StartTime.Format("2006-01-02T15:04:05Z07:00"),
+		Duration:    entry.Duration().Hours(),
+		Description: entry.Description,
+		IssueKey:    entry.IssueKey,
+		Notes:       entry.Notes,
+		Billable:    entry.Billable,
+	}
+
+	if entry.EndTime != nil {
+		export.EndTime = entry.EndTime.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	if entry.MilestoneName != nil {
+		export.Milestone = *entry.MilestoneName
+	}
+
+	for _, commit := range entry.Commits {
+		export.Commits = append(export.Commits, ExportCommit{
+			SHA:         commit.SHA,
+			Message:     commit.Message,
+			CommittedAt: commit.CommittedAt.Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+
+	return export
+}
+
+func ToJson(entries []*storage.TimeEntry, filename string, lookup Billing) error {
 	var exportEntries []ExportEntry
 
 	for _, entry := range entries {
-		export := ExportEntry{
-			Project:     entry.ProjectName,
-			StartTime:   entry.StartTime.Format("2006-01-02T15:04:05Z07:00"),
-			Duration:    entry.Duration().Hours(),
-			Description: entry.Description,
-		}
-
-		if entry.EndTime != nil {
-			export.EndTime = entry.EndTime.Format("2006-01-02T15:04:05Z07:00")
-		}
-
-		if entry.MilestoneName != nil {
-			export.Milestone = *entry.MilestoneName
-		}
-
-		exportEntries = append(exportEntries, export)
+		exportEntry := NewExportEntry(entry)
+		exportEntry.Billing = NewExportBilling(entry, lookup)
+		exportEntries = append(exportEntries, exportEntry)
 	}
 
 	file, err := os.Create(filename)
@@ -54,4 +83,4 @@ func ToJson(entries []*storage.TimeEntry, filename string) error {
 	}
 
 	return nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,154 @@
+package goals
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/DylanDevelops/tmpo/internal/ui"
+)
+
+// Progress describes how much of a working-hours goal has been tracked.
+type Progress struct {
+	Target  time.Duration
+	Tracked time.Duration
+}
+
+// NewProgress builds a Progress from a goal expressed in hours.
+func NewProgress(goalHours float64, tracked time.Duration) Progress {
+	return Progress{
+		Target:  time.Duration(goalHours * float64(time.Hour)),
+		Tracked: tracked,
+	}
+}
+
+func (p Progress) Percent() float64 {
+	if p.Target <= 0 {
+		return 0
+	}
+
+	return (p.Tracked.Seconds() / p.Target.Seconds()) * 100
+}
+
+func (p Progress) Met() bool {
+	return p.Target > 0 && p.Tracked >= p.Target
+}
+
+func (p Progress) Remaining() time.Duration {
+	if p.Met() {
+		return 0
+	}
+
+	return p.Target - p.Tracked
+}
+
+// String renders the progress as "3h 12m 0s of 6h 0m 0s (53%)".
+func (p Progress) String() string {
+	return fmt.Sprintf("%s of %s (%.0f%%)", ui.FormatDuration(p.Tracked), ui.FormatDuration(p.Target), p.Percent())
+}
+
+func StartOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// StartOfWeek returns midnight on the Monday of the week containing t.
+func StartOfWeek(t time.Time) time.Time {
+	weekday := int(t.Weekday())
+	if weekday == 0 {
+		weekday = 7 // sunday
+	}
+
+	return StartOfDay(t).AddDate(0, 0, -weekday+1)
+}
+
+// TrackedBetween sums the time tracked inside [start, end). Entries that
+// cross a boundary only count for the part that falls inside the range.
+func TrackedBetween(entries []*storage.TimeEntry, start, end time.Time) time.Duration {
+	var total time.Duration
+
+	for _, entry := range entries {
+		entryStart := entry.StartTime
+		entryEnd := time.Now()
+		if entry.EndTime != nil {
+			entryEnd = *entry.EndTime
+		}
+
+		if entryStart.Before(start) {
+			entryStart = start
+		}
+
+		if entryEnd.After(end) {
+			entryEnd = end
+		}
+
+		if entryEnd.After(entryStart) {
+			total += entryEnd.Sub(entryStart)
+		}
+	}
+
+	return total
+}
+
+// FilterByProject returns the entries that belong to projectName.
+func FilterByProject(entries []*storage.TimeEntry, projectName string) []*storage.TimeEntry {
+	var filtered []*storage.TimeEntry
+
+	for _, entry := range entries {
+		if entry.ProjectName == projectName {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	return filtered
+}
+
+// Streak returns the number of consecutive days up to now on which the daily
+// goal was met, along with the longest such run in the entry history. Today
+// only extends the current streak once the goal has been reached, so an
+// unfinished day does not break it.
+func Streak(entries []*storage.TimeEntry, goalHours float64, now time.Time) (current, longest int) {
+	if goalHours <= 0 || len(entries) == 0 {
+		return 0, 0
+	}
+
+	goal := time.Duration(goalHours * float64(time.Hour))
+	loc := now.Location()
+
+	earliest := now
+	for _, entry := range entries {
+		if entry.StartTime.Before(earliest) {
+			earliest = entry.StartTime
+		}
+	}
+
+	daily := make(map[string]time.Duration)
+	for _, entry := range entries {
+		entryStart := entry.StartTime.In(loc)
+		entryEnd := now
+		if entry.EndTime != nil {
+			entryEnd = entry.EndTime.In(loc)
+		}
+
+		// split entries that run past midnight across the days they cover
+		for day := StartOfDay(entryStart); day.Before(entryEnd); day = day.AddDate(0, 0, 1) {
+			daily[day.Format("2006-01-02")] += TrackedBetween([]*storage.TimeEntry{entry}, day, day.AddDate(0, 0, 1))
+		}
+	}
+
+	today := StartOfDay(now)
+	run := 0
+	for day := StartOfDay(earliest.In(loc)); !day.After(today); day = day.AddDate(0, 0, 1) {
+		if daily[day.Format("2006-01-02")] >= goal {
+			run++
+			if run > longest {
+				longest = run
+			}
+		} else if !day.Equal(today) {
+			run = 0
+		}
+	}
+
+	current = run
+
+	return current, longest
+}
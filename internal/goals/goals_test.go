@@ -0,0 +1,133 @@
+package goals
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func entryBetween(start, end time.Time) *storage.TimeEntry {
+	return &storage.TimeEntry{ProjectName: "test-project", StartTime: start, EndTime: &end}
+}
+
+func TestProgress(t *testing.T) {
+	t.Run("partial progress", func(t *testing.T) {
+		progress := NewProgress(6, 3*time.Hour)
+		assert.Equal(t, 6*time.Hour, progress.Target)
+		assert.InDelta(t, 50.0, progress.Percent(), 0.001)
+		assert.False(t, progress.Met())
+		assert.Equal(t, 3*time.Hour, progress.Remaining())
+	})
+
+	t.Run("goal met", func(t *testing.T) {
+		progress := NewProgress(6, 7*time.Hour)
+		assert.True(t, progress.Met())
+		assert.Equal(t, time.Duration(0), progress.Remaining())
+	})
+
+	t.Run("no goal", func(t *testing.T) {
+		progress := NewProgress(0, time.Hour)
+		assert.Equal(t, 0.0, progress.Percent())
+		assert.False(t, progress.Met())
+	})
+}
+
+func TestStartOfWeek(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    time.Time
+		expected time.Time
+	}{
+		{
+			name:     "wednesday",
+			input:    time.Date(2026, 10, 14, 15, 30, 0, 0, time.UTC),
+			expected: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "monday",
+			input:    time.Date(2026, 10, 12, 8, 0, 0, 0, time.UTC),
+			expected: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "sunday belongs to the previous week",
+			input:    time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC),
+			expected: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, StartOfWeek(tt.input))
+		})
+	}
+}
+
+func TestTrackedBetween(t *testing.T) {
+	day := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+
+	entries := []*storage.TimeEntry{
+		entryBetween(day.Add(9*time.Hour), day.Add(12*time.Hour)),
+		// crosses midnight into the day, only the last hour counts
+		entryBetween(day.Add(-1*time.Hour), day.Add(1*time.Hour)),
+		// entirely outside the range
+		entryBetween(day.Add(-5*time.Hour), day.Add(-3*time.Hour)),
+	}
+
+	tracked := TrackedBetween(entries, day, day.AddDate(0, 0, 1))
+	assert.Equal(t, 4*time.Hour, tracked)
+}
+
+func TestStreak(t *testing.T) {
+	now := time.Date(2026, 10, 14, 10, 0, 0, 0, time.UTC)
+	today := StartOfDay(now)
+
+	workDay := func(daysAgo int, hours int) *storage.TimeEntry {
+		start := today.AddDate(0, 0, -daysAgo).Add(9 * time.Hour)
+		return entryBetween(start, start.Add(time.Duration(hours)*time.Hour))
+	}
+
+	t.Run("counts consecutive days up to yesterday when today is not done", func(t *testing.T) {
+		entries := []*storage.TimeEntry{
+			workDay(5, 6),
+			workDay(4, 2), // breaks the streak
+			workDay(3, 6),
+			workDay(2, 7),
+			workDay(1, 6),
+		}
+
+		current, longest := Streak(entries, 6, now)
+		assert.Equal(t, 3, current)
+		assert.Equal(t, 3, longest)
+	})
+
+	t.Run("today extends the streak once met", func(t *testing.T) {
+		entries := []*storage.TimeEntry{
+			workDay(1, 6),
+			entryBetween(today.Add(1*time.Hour), today.Add(7*time.Hour)),
+		}
+
+		current, _ := Streak(entries, 6, now)
+		assert.Equal(t, 2, current)
+	})
+
+	t.Run("missed day resets current but keeps longest", func(t *testing.T) {
+		entries := []*storage.TimeEntry{
+			workDay(6, 6),
+			workDay(5, 6),
+			workDay(4, 6),
+			workDay(1, 6),
+		}
+
+		current, longest := Streak(entries, 6, now)
+		assert.Equal(t, 1, current)
+		assert.Equal(t, 3, longest)
+	})
+
+	t.Run("no goal configured", func(t *testing.T) {
+		current, longest := Streak([]*storage.TimeEntry{workDay(1, 8)}, 0, now)
+		assert.Equal(t, 0, current)
+		assert.Equal(t, 0, longest)
+	})
+}
@@ -0,0 +1,158 @@
+package pomodoro
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+)
+
+// Tag is added to every focus block that runs to completion so completed
+// pomodoros can be told apart from interrupted ones.
+const Tag = "pomodoro"
+
+const (
+	DefaultFocus          = 25 * time.Minute
+	DefaultShortBreak     = 5 * time.Minute
+	DefaultLongBreak      = 15 * time.Minute
+	DefaultLongBreakEvery = 4
+)
+
+// Phase identifies which part of the cycle is running.
+type Phase string
+
+const (
+	PhaseFocus      Phase = "focus"
+	PhaseShortBreak Phase = "short_break"
+	PhaseLongBreak  Phase = "long_break"
+	PhaseDone       Phase = "done"
+)
+
+// Label returns a human readable name for the phase.
+func (p Phase) Label() string {
+	switch p {
+	case PhaseFocus:
+		return "Focus"
+	case PhaseShortBreak:
+		return "Short Break"
+	case PhaseLongBreak:
+		return "Long Break"
+	default:
+		return "Done"
+	}
+}
+
+type Settings struct {
+	Focus          time.Duration
+	ShortBreak     time.Duration
+	LongBreak      time.Duration
+	LongBreakEvery int
+}
+
+func DefaultSettings() Settings {
+	return Settings{
+		Focus:          DefaultFocus,
+		ShortBreak:     DefaultShortBreak,
+		LongBreak:      DefaultLongBreak,
+		LongBreakEvery: DefaultLongBreakEvery,
+	}
+}
+
+func (s Settings) Validate() error {
+	if s.Focus <= 0 {
+		return fmt.Errorf("focus length must be greater than 0")
+	}
+
+	if s.ShortBreak < 0 || s.LongBreak < 0 {
+		return fmt.Errorf("break lengths cannot be negative")
+	}
+
+	if s.LongBreakEvery < 1 {
+		return fmt.Errorf("long break interval must be at least 1")
+	}
+
+	return nil
+}
+
+// BreakAfter returns the break that follows the given completed focus block
+// (1-based). Every LongBreakEvery-th block is followed by a long break.
+func (s Settings) BreakAfter(completed int) (Phase, time.Duration) {
+	if completed > 0 && completed%s.LongBreakEvery == 0 {
+		return PhaseLongBreak, s.LongBreak
+	}
+
+	return PhaseShortBreak, s.ShortBreak
+}
+
+// FormatCountdown renders a remaining duration as MM:SS, or H:MM:SS for
+// durations of an hour or more.
+func FormatCountdown(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+
+	d = d.Round(time.Second)
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	seconds := int(d.Seconds()) % 60
+
+	if hours > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", hours, minutes, seconds)
+	}
+
+	return fmt.Sprintf("%02d:%02d", minutes, seconds)
+}
+
+type Summary struct {
+	Completed int
+	Focus     time.Duration
+}
+
+// Summarize counts the completed pomodoros among the given entries.
+func Summarize(entries []*storage.TimeEntry) Summary {
+	var summary Summary
+	for _, entry := range entries {
+		if entry.EndTime == nil || !entry.HasTag(Tag) {
+			continue
+		}
+
+		summary.Completed++
+		summary.Focus += entry.Duration()
+	}
+
+	return summary
+}
+
+// RunHook runs the user configured hook command for a phase transition. The
+// command is run through the system shell without waiting for it to finish,
+// and receives the transition details through TMPO_POMODORO_* variables.
+func RunHook(command string, finished, next Phase, projectName string, completed int) error {
+	if command == "" {
+		return nil
+	}
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+
+	cmd.Env = append(os.Environ(),
+		"TMPO_POMODORO_FINISHED="+string(finished),
+		"TMPO_POMODORO_NEXT="+string(next),
+		"TMPO_POMODORO_PROJECT="+projectName,
+		fmt.Sprintf("TMPO_POMODORO_COMPLETED=%d", completed),
+	)
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to run pomodoro hook: %w", err)
+	}
+
+	go cmd.Wait()
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package pomodoro
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreakAfter(t *testing.T) {
+	settings := DefaultSettings()
+
+	phase, length := settings.BreakAfter(1)
+	assert.Equal(t, PhaseShortBreak, phase)
+	assert.Equal(t, DefaultShortBreak, length)
+
+	phase, length = settings.BreakAfter(4)
+	assert.Equal(t, PhaseLongBreak, phase)
+	assert.Equal(t, DefaultLongBreak, length)
+
+	phase, _ = settings.BreakAfter(5)
+	assert.Equal(t, PhaseShortBreak, phase)
+
+	phase, _ = settings.BreakAfter(8)
+	assert.Equal(t, PhaseLongBreak, phase)
+}
+
+func TestSettingsValidate(t *testing.T) {
+	assert.NoError(t, DefaultSettings().Validate())
+
+	invalid := DefaultSettings()
+	invalid.Focus = 0
+	assert.Error(t, invalid.Validate())
+
+	invalid = DefaultSettings()
+	invalid.LongBreakEvery = 0
+	assert.Error(t, invalid.Validate())
+
+	invalid = DefaultSettings()
+	invalid.ShortBreak = -time.Minute
+	assert.Error(t, invalid.Validate())
+}
+
+func TestFormatCountdown(t *testing.T) {
+	tests := []struct {
+		input    time.Duration
+		expected string
+	}{
+		{25 * time.Minute, "25:00"},
+		{4*time.Minute + 5*time.Second, "04:05"},
+		{90 * time.Minute, "1:30:00"},
+		{-time.Second, "00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatCountdown(tt.input))
+		})
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	start := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(25 * time.Minute)
+	interruptedEnd := start.Add(10 * time.Minute)
+
+	entries := []*storage.TimeEntry{
+		{StartTime: start, EndTime: &end, Tags: []string{"Pomodoro"}},
+		{StartTime: start, EndTime: &end, Tags: []string{"deep-work", Tag}},
+		{StartTime: start, EndTime: &interruptedEnd, Tags: []string{"deep-work"}},
+		{StartTime: start, EndTime: &end},
+		{StartTime: start, Tags: []string{Tag}},
+	}
+
+	summary := Summarize(entries)
+	assert.Equal(t, 2, summary.Completed)
+	assert.Equal(t, 50*time.Minute, summary.Focus)
+}
@@ -0,0 +1,129 @@
+package project
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+)
+
+// DefaultBranchPattern splits branches like "feature/PROJ-123-login-form" into
+// an issue key ("PROJ-123") and a description ("login-form"). Prefixes such as
+// "feature/" are ignored and the issue key is optional.
+const DefaultBranchPattern = `^(?:[^/]+/)*(?P<issue>[A-Za-z][A-Za-z0-9]+-\d+)?[-_]*(?P<description>.*)$`
+
+// trunkBranches never produce a description; "Main" isn't a useful one.
+var trunkBranches = map[string]bool{
+	"main":    true,
+	"master":  true,
+	"develop": true,
+	"trunk":   true,
+}
+
+// BranchInfo holds what could be derived from a branch name.
+type BranchInfo struct {
+	Description string
+	IssueKey    string
+	Milestone   string
+}
+
+// ParseBranch applies pattern (or DefaultBranchPattern when empty) to branch.
+// The named groups "issue" and "description" are used when present; without a
+// "description" group the whole branch name becomes the description.
+func ParseBranch(branch, pattern string) (*BranchInfo, error) {
+	if pattern == "" {
+		pattern = DefaultBranchPattern
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid branch_pattern: %w", err)
+	}
+
+	info := &BranchInfo{}
+	if trunkBranches[branch] {
+		return info, nil
+	}
+
+	match := re.FindStringSubmatch(branch)
+	if match == nil {
+		return info, nil
+	}
+
+	hasDescriptionGroup := false
+	for i, name := range re.SubexpNames() {
+		switch name {
+		case "issue":
+			info.IssueKey = strings.ToUpper(match[i])
+		case "description":
+			hasDescriptionGroup = true
+			info.Description = humanizeBranch(match[i])
+		}
+	}
+
+	if !hasDescriptionGroup {
+		info.Description = humanizeBranch(branch)
+	}
+
+	return info, nil
+}
+
+// humanizeBranch turns "login-form" into "Login form".
+func humanizeBranch(value string) string {
+	value = strings.NewReplacer("-", " ", "_", " ", "/", " ").Replace(value)
+	value = strings.Join(strings.Fields(value), " ")
+	if value == "" {
+		return ""
+	}
+
+	runes := []rune(value)
+	runes[0] = unicode.ToUpper(runes[0])
+
+	return string(runes)
+}
+
+// MatchBranchMilestone returns the milestone of the first rule whose pattern
+// matches branch, or "" when none do.
+func MatchBranchMilestone(branch string, rules []settings.BranchMilestone) string {
+	for _, rule := range rules {
+		if matched, err := path.Match(rule.Pattern, branch); err == nil && matched {
+			return rule.Milestone
+		}
+	}
+
+	return ""
+}
+
+// DeriveFromBranch reads the current branch and applies the .tmporc branch
+// settings. It returns nil when there is no .tmporc, branch derivation is not
+// configured or HEAD is detached.
+func DeriveFromBranch() (*BranchInfo, error) {
+	cfg, _, err := settings.FindAndLoad()
+	if err != nil || cfg == nil {
+		return nil, nil
+	}
+
+	if !cfg.BranchDescription && len(cfg.BranchMilestones) == 0 {
+		return nil, nil
+	}
+
+	branch, err := GetCurrentBranch()
+	if err != nil {
+		return nil, nil
+	}
+
+	info := &BranchInfo{}
+	if cfg.BranchDescription {
+		info, err = ParseBranch(branch, cfg.BranchPattern)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	info.Milestone = MatchBranchMilestone(branch, cfg.BranchMilestones)
+
+	return info, nil
+}
@@ -0,0 +1,89 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBranch(t *testing.T) {
+	tests := []struct {
+		name        string
+		branch      string
+		pattern     string
+		description string
+		issueKey    string
+	}{
+		{
+			name:        "prefix, issue key and description",
+			branch:      "feature/PROJ-123-login-form",
+			description: "Login form",
+			issueKey:    "PROJ-123",
+		},
+		{
+			name:        "lowercase issue key is normalized",
+			branch:      "bugfix/abc-42_fix_crash",
+			description: "Fix crash",
+			issueKey:    "ABC-42",
+		},
+		{
+			name:        "no issue key",
+			branch:      "fix-typo-in-readme",
+			description: "Fix typo in readme",
+		},
+		{
+			name:        "issue key only",
+			branch:      "PROJ-7",
+			description: "",
+			issueKey:    "PROJ-7",
+		},
+		{
+			name:        "trunk branches produce nothing",
+			branch:      "main",
+			description: "",
+		},
+		{
+			name:        "custom pattern with only an issue group",
+			branch:      "jdoe/PAY-9/refund",
+			pattern:     `(?P<issue>PAY-\d+)`,
+			description: "Jdoe PAY 9 refund",
+			issueKey:    "PAY-9",
+		},
+		{
+			name:        "custom pattern that does not match",
+			branch:      "experiment",
+			pattern:     `^ticket/(?P<issue>\d+)-(?P<description>.+)$`,
+			description: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := ParseBranch(tt.branch, tt.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tt.description, info.Description)
+			assert.Equal(t, tt.issueKey, info.IssueKey)
+		})
+	}
+}
+
+func TestParseBranchInvalidPattern(t *testing.T) {
+	_, err := ParseBranch("feature/x", "(")
+	assert.Error(t, err)
+}
+
+func TestMatchBranchMilestone(t *testing.T) {
+	rules := []settings.BranchMilestone{
+		{Pattern: "release/2.0/*", Milestone: "v2.0"},
+		{Pattern: "release/*", Milestone: "Release"},
+		{Pattern: "hotfix-*", Milestone: "Hotfixes"},
+	}
+
+	assert.Equal(t, "v2.0", MatchBranchMilestone("release/2.0/rc1", rules))
+	assert.Equal(t, "Release", MatchBranchMilestone("release/1.9", rules))
+	assert.Equal(t, "Hotfixes", MatchBranchMilestone("hotfix-login", rules))
+	assert.Equal(t, "", MatchBranchMilestone("feature/PROJ-1-login", rules))
+	assert.Equal(t, "", MatchBranchMilestone("release/1.9", nil))
+}
@@ -7,6 +7,8 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/DylanDevelops/tmpo/internal/billing"
+	"github.com/DylanDevelops/tmpo/internal/currency"
 	"github.com/DylanDevelops/tmpo/internal/settings"
 )
 
@@ -18,7 +20,12 @@ func DetectProject() (string, error) {
 		return filepath.Base(dir), nil
 	}
 
-	gitName, err := GetGitRepoName()
+	byRemote := false
+	if globalCfg, err := settings.LoadGlobalConfig(); err == nil {
+		byRemote = globalCfg.DetectByGitRemote
+	}
+
+	gitName, err := DetectGitProject(byRemote)
 	if err == nil && gitName != "" {
 		return gitName, nil
 	}
@@ -31,12 +38,11 @@ func DetectProject() (string, error) {
 	return filepath.Base(cwd), nil
 }
 
-
 func DetectConfiguredProject() (string, error) {
 	return DetectConfiguredProjectWithOverride("")
 }
 
-// DetectConfiguredProjectWithOverride detects the project (priority: specific project name > .tmporc > git repo > directory name)
+// DetectConfiguredProjectWithOverride detects the project (priority: specific project name > .tmporc > project paths > git repo > directory name)
 func DetectConfiguredProjectWithOverride(explicitProject string) (string, error) {
 	// first priority: --project flag
 	if explicitProject != "" {
@@ -65,10 +71,40 @@ func DetectConfiguredProjectWithOverride(explicitProject string) (string, error)
 		}
 	}
 
-	// third priority: directory-based detection
+	// third priority: directories mapped to a project in the global registry or config
+	if projectName, _ := DetectPathProject(); projectName != "" {
+		return projectName, nil
+	}
+
+	// fourth priority: directory-based detection
 	return DetectProject()
 }
 
+// DetectPathProject returns the project the current directory is mapped to by
+// a global project's paths or the global project_paths rules, along with a
+// short description of where the mapping came from. Both are empty when the
+// directory isn't mapped.
+func DetectPathProject() (projectName string, source string) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", ""
+	}
+
+	if registry, err := settings.LoadProjects(); err == nil {
+		if globalProject := registry.ProjectForPath(cwd); globalProject != nil {
+			return globalProject.Name, "global project"
+		}
+	}
+
+	if globalCfg, err := settings.LoadGlobalConfig(); err == nil {
+		if projectName := globalCfg.ProjectForPath(cwd); projectName != "" {
+			return projectName, "project_paths rule"
+		}
+	}
+
+	return "", ""
+}
+
 func FindTmporc() (string, error) {
 	dir, err := os.Getwd()
 	if err != nil {
@@ -121,29 +157,136 @@ func GetGitRoot() (string, error) {
 	return strings.TrimSpace(string(output)), nil
 }
 
-// GetProjectConfig retrieves project configuration for a given project name.
-// Returns hourly rate and export path if configured
-func GetProjectConfig(projectName string) (*float64, string, error) {
+// ProjectSettings is a project's configuration: from the global registry when
+// the project is registered, otherwise from the .tmporc that names it. Values
+// the configuration leaves unset are nil or empty.
+type ProjectSettings struct {
+	HourlyRate      *float64
+	ExportPath      string
+	Currency        string
+	TaxRate         *float64
+	Discount        *float64
+	Billable        *bool
+	DailyGoalHours  *float64
+	WeeklyGoalHours *float64
+}
+
+// LoadProjectSettings resolves a project's configuration. Callers that need
+// several settings of a project should load them once with this.
+func LoadProjectSettings(projectName string) ProjectSettings {
 	// check if global project
 	registry, err := settings.LoadProjects()
 	if err == nil && registry.Exists(projectName) {
 		project, err := registry.GetProject(projectName)
-		if err == nil {
-			return project.HourlyRate, project.ExportPath, nil
+		if err != nil {
+			return ProjectSettings{}
+		}
+
+		return ProjectSettings{
+			HourlyRate:      project.HourlyRate,
+			ExportPath:      project.ExportPath,
+			Currency:        project.Currency,
+			TaxRate:         project.TaxRate,
+			Discount:        project.Discount,
+			Billable:        project.Billable,
+			DailyGoalHours:  project.DailyGoalHours,
+			WeeklyGoalHours: project.WeeklyGoalHours,
 		}
 	}
 
 	// fall back to .tmporc
 	cfg, _, err := settings.FindAndLoad()
-	if err == nil && cfg != nil && cfg.ProjectName == projectName {
-		var hourlyRate *float64
-		if cfg.HourlyRate > 0 {
-			rate := cfg.HourlyRate
-			hourlyRate = &rate
-		}
-		return hourlyRate, cfg.ExportPath, nil
+	if err != nil || cfg == nil || cfg.ProjectName != projectName {
+		return ProjectSettings{}
 	}
 
-	// no configuration exists
-	return nil, "", nil
+	return ProjectSettings{
+		HourlyRate:      positive(cfg.HourlyRate),
+		ExportPath:      cfg.ExportPath,
+		Currency:        cfg.Currency,
+		TaxRate:         cfg.TaxRate,
+		Discount:        positive(cfg.Discount),
+		Billable:        cfg.Billable,
+		DailyGoalHours:  positive(cfg.DailyGoalHours),
+		WeeklyGoalHours: positive(cfg.WeeklyGoalHours),
+	}
+}
+
+// positive returns a pointer to value, or nil for the zero value .tmporc
+// uses for an unset number.
+func positive(value float64) *float64 {
+	if value <= 0 {
+		return nil
+	}
+
+	return &value
+}
+
+// IsBillable reports whether new entries of the project are billable by
+// default. Projects are billable unless their configuration says otherwise.
+func (s ProjectSettings) IsBillable() bool {
+	return s.Billable == nil || *s.Billable
+}
+
+// CurrencyCode returns the currency the project is billed in: its own
+// currency if configured, otherwise the global currency.
+func (s ProjectSettings) CurrencyCode() string {
+	if s.Currency != "" {
+		return strings.ToUpper(s.Currency)
+	}
+
+	globalCfg, err := settings.LoadGlobalConfig()
+	if err != nil {
+		return currency.DefaultCurrency
+	}
+
+	return globalCfg.Currency
+}
+
+// Terms returns the discount and tax applied to the project's earnings. A
+// project without its own tax rate uses the global one.
+func (s ProjectSettings) Terms() billing.Terms {
+	var terms billing.Terms
+	if s.Discount != nil {
+		terms.Discount = *s.Discount
+	}
+
+	if s.TaxRate != nil {
+		terms.TaxRate = *s.TaxRate
+	} else if globalCfg, err := settings.LoadGlobalConfig(); err == nil {
+		terms.TaxRate = globalCfg.TaxRate
+	}
+
+	return terms
+}
+
+// GetProjectConfig retrieves project configuration for a given project name.
+// Returns hourly rate and export path if configured
+func GetProjectConfig(projectName string) (*float64, string, error) {
+	projectSettings := LoadProjectSettings(projectName)
+	return projectSettings.HourlyRate, projectSettings.ExportPath, nil
+}
+
+// IsProjectBillable reports whether new entries of a project are billable by
+// default.
+func IsProjectBillable(projectName string) bool {
+	return LoadProjectSettings(projectName).IsBillable()
+}
+
+// GetProjectCurrency returns the currency a project is billed in.
+func GetProjectCurrency(projectName string) string {
+	return LoadProjectSettings(projectName).CurrencyCode()
+}
+
+// GetProjectTerms returns the discount and tax applied to a project's
+// earnings.
+func GetProjectTerms(projectName string) billing.Terms {
+	return LoadProjectSettings(projectName).Terms()
+}
+
+// GetProjectGoals retrieves the daily and weekly working-hours goals configured
+// for a project. Nil values mean no project-specific goal is set.
+func GetProjectGoals(projectName string) (daily *float64, weekly *float64) {
+	projectSettings := LoadProjectSettings(projectName)
+	return projectSettings.DailyGoalHours, projectSettings.WeeklyGoalHours
 }
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/DylanDevelops/tmpo/internal/billing"
 	"github.com/DylanDevelops/tmpo/internal/settings"
 	"github.com/stretchr/testify/assert"
 )
@@ -403,3 +404,210 @@ export_path: /tmp/local
 		assert.Equal(t, "/tmp/global", exportPath)
 	})
 }
+
+func TestIsProjectBillable(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	defer os.Setenv("HOME", originalHome)
+
+	os.Setenv("HOME", tmpDir)
+	os.Setenv("TMPO_DEV", "1")
+
+	nonBillable := false
+	registry := &settings.ProjectsRegistry{
+		Projects: []settings.GlobalProject{
+			{Name: "Internal", Billable: &nonBillable},
+			{Name: "Client"},
+		},
+	}
+	assert.NoError(t, registry.Save())
+
+	t.Run("reads global projects", func(t *testing.T) {
+		assert.False(t, IsProjectBillable("Internal"))
+		assert.True(t, IsProjectBillable("Client"))
+	})
+
+	t.Run("reads tmporc", func(t *testing.T) {
+		originalDir, err := os.Getwd()
+		assert.NoError(t, err)
+		defer os.Chdir(originalDir)
+
+		projectDir := t.TempDir()
+		content := `project_name: Side Project
+billable: false
+`
+		err = os.WriteFile(filepath.Join(projectDir, ".tmporc"), []byte(content), 0644)
+		assert.NoError(t, err)
+
+		err = os.Chdir(projectDir)
+		assert.NoError(t, err)
+
+		assert.False(t, IsProjectBillable("Side Project"))
+	})
+
+	t.Run("defaults to billable", func(t *testing.T) {
+		assert.True(t, IsProjectBillable("Unknown"))
+	})
+}
+
+func TestGetProjectCurrency(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	defer os.Setenv("HOME", originalHome)
+
+	os.Setenv("HOME", tmpDir)
+	os.Setenv("TMPO_DEV", "1")
+
+	registry := &settings.ProjectsRegistry{
+		Projects: []settings.GlobalProject{
+			{Name: "Acme GmbH", Currency: "eur"},
+			{Name: "Client"},
+		},
+	}
+	assert.NoError(t, registry.Save())
+
+	globalCfg := settings.DefaultGlobalConfig()
+	globalCfg.Currency = "GBP"
+	assert.NoError(t, globalCfg.Save())
+
+	t.Run("reads global projects", func(t *testing.T) {
+		assert.Equal(t, "EUR", GetProjectCurrency("Acme GmbH"))
+		assert.Equal(t, "GBP", GetProjectCurrency("Client"))
+	})
+
+	t.Run("reads tmporc", func(t *testing.T) {
+		originalDir, err := os.Getwd()
+		assert.NoError(t, err)
+		defer os.Chdir(originalDir)
+
+		projectDir := t.TempDir()
+		content := `project_name: Side Project
+currency: CHF
+`
+		err = os.WriteFile(filepath.Join(projectDir, ".tmporc"), []byte(content), 0644)
+		assert.NoError(t, err)
+
+		err = os.Chdir(projectDir)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "CHF", GetProjectCurrency("Side Project"))
+	})
+
+	t.Run("defaults to the global currency", func(t *testing.T) {
+		assert.Equal(t, "GBP", GetProjectCurrency("Unknown"))
+	})
+}
+
+func TestGetProjectTerms(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	defer os.Setenv("HOME", originalHome)
+
+	os.Setenv("HOME", tmpDir)
+	os.Setenv("TMPO_DEV", "1")
+
+	discount := 10.0
+	exempt := 0.0
+	registry := &settings.ProjectsRegistry{
+		Projects: []settings.GlobalProject{
+			{Name: "Acme GmbH", Discount: &discount},
+			{Name: "Export Client", TaxRate: &exempt},
+		},
+	}
+	assert.NoError(t, registry.Save())
+
+	globalCfg := settings.DefaultGlobalConfig()
+	globalCfg.TaxRate = 19
+	assert.NoError(t, globalCfg.Save())
+
+	t.Run("reads global projects", func(t *testing.T) {
+		assert.Equal(t, billing.Terms{Discount: 10, TaxRate: 19}, GetProjectTerms("Acme GmbH"))
+		assert.Equal(t, billing.Terms{}, GetProjectTerms("Export Client"))
+	})
+
+	t.Run("reads tmporc", func(t *testing.T) {
+		originalDir, err := os.Getwd()
+		assert.NoError(t, err)
+		defer os.Chdir(originalDir)
+
+		projectDir := t.TempDir()
+		content := `project_name: Side Project
+tax_rate: 7.7
+discount: 5
+`
+		err = os.WriteFile(filepath.Join(projectDir, ".tmporc"), []byte(content), 0644)
+		assert.NoError(t, err)
+
+		err = os.Chdir(projectDir)
+		assert.NoError(t, err)
+
+		assert.Equal(t, billing.Terms{Discount: 5, TaxRate: 7.7}, GetProjectTerms("Side Project"))
+	})
+
+	t.Run("defaults to the global tax rate", func(t *testing.T) {
+		assert.Equal(t, billing.Terms{TaxRate: 19}, GetProjectTerms("Unknown"))
+	})
+}
+
+func TestLoadProjectSettings(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalHome := os.Getenv("HOME")
+	defer os.Setenv("HOME", originalHome)
+
+	os.Setenv("HOME", tmpDir)
+	os.Setenv("TMPO_DEV", "1")
+
+	rate := 90.0
+	taxRate := 19.0
+	daily := 6.0
+	registry := &settings.ProjectsRegistry{
+		Projects: []settings.GlobalProject{
+			{Name: "Client", HourlyRate: &rate, Currency: "eur", TaxRate: &taxRate, DailyGoalHours: &daily},
+		},
+	}
+	assert.NoError(t, registry.Save())
+
+	t.Run("reads global projects", func(t *testing.T) {
+		projectSettings := LoadProjectSettings("Client")
+		assert.Equal(t, &rate, projectSettings.HourlyRate)
+		assert.Equal(t, "EUR", projectSettings.CurrencyCode())
+		assert.Equal(t, 19.0, projectSettings.Terms().TaxRate)
+		assert.Equal(t, &daily, projectSettings.DailyGoalHours)
+		assert.Nil(t, projectSettings.WeeklyGoalHours)
+		assert.True(t, projectSettings.IsBillable())
+	})
+
+	t.Run("reads tmporc", func(t *testing.T) {
+		originalDir, err := os.Getwd()
+		assert.NoError(t, err)
+		defer os.Chdir(originalDir)
+
+		projectDir := t.TempDir()
+		content := `project_name: Side Project
+hourly_rate: 40
+discount: 10
+billable: false
+weekly_goal_hours: 20
+`
+		err = os.WriteFile(filepath.Join(projectDir, ".tmporc"), []byte(content), 0644)
+		assert.NoError(t, err)
+
+		err = os.Chdir(projectDir)
+		assert.NoError(t, err)
+
+		projectSettings := LoadProjectSettings("Side Project")
+		if assert.NotNil(t, projectSettings.HourlyRate) {
+			assert.Equal(t, 40.0, *projectSettings.HourlyRate)
+		}
+		assert.Equal(t, 10.0, projectSettings.Terms().Discount)
+		assert.False(t, projectSettings.IsBillable())
+		assert.Nil(t, projectSettings.DailyGoalHours)
+		if assert.NotNil(t, projectSettings.WeeklyGoalHours) {
+			assert.Equal(t, 20.0, *projectSettings.WeeklyGoalHours)
+		}
+	})
+
+	t.Run("leaves unknown projects unset", func(t *testing.T) {
+		assert.Equal(t, ProjectSettings{}, LoadProjectSettings("Unknown"))
+	})
+}
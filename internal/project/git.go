@@ -0,0 +1,130 @@
+package project
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// GitCommit is the subset of commit information tmpo records.
+type GitCommit struct {
+	SHA     string
+	Subject string
+	Time    time.Time
+}
+
+// ShortSHA returns the abbreviated commit hash shown in messages.
+func (c GitCommit) ShortSHA() string {
+	if len(c.SHA) > 7 {
+		return c.SHA[:7]
+	}
+
+	return c.SHA
+}
+
+// GetGitHooksDir returns the absolute path of the hooks directory for the
+// current repository, honoring worktrees and core.hooksPath.
+func GetGitHooksDir() (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--git-path", "hooks")
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("not in a git repository")
+	}
+
+	return filepath.Abs(strings.TrimSpace(string(output)))
+}
+
+// GetCurrentBranch returns the checked out branch name, or an error when HEAD
+// is detached.
+func GetCurrentBranch() (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD")
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("not in a git repository")
+	}
+
+	branch := strings.TrimSpace(string(output))
+	if branch == "HEAD" {
+		return "", fmt.Errorf("HEAD is detached")
+	}
+
+	return branch, nil
+}
+
+// GetHeadCommit returns the commit currently checked out.
+func GetHeadCommit() (*GitCommit, error) {
+	cmd := exec.Command("git", "log", "-1", "--format=%H%x00%s%x00%cI")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read HEAD commit: %w", err)
+	}
+
+	return parseGitCommit(strings.TrimSpace(string(output)))
+}
+
+// parseGitCommit parses a line produced with --format=%H%x00%s%x00%cI.
+func parseGitCommit(line string) (*GitCommit, error) {
+	parts := strings.Split(line, "\x00")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("unexpected git log output: %q", line)
+	}
+
+	committedAt, err := time.Parse(time.RFC3339, parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid commit date %q: %w", parts[2], err)
+	}
+
+	return &GitCommit{SHA: parts[0], Subject: parts[1], Time: committedAt}, nil
+}
+
+// GetGitUserEmail returns the configured user.email for the current repository.
+func GetGitUserEmail() (string, error) {
+	cmd := exec.Command("git", "config", "user.email")
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git user.email is not configured")
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// GetGitLog returns the non-merge commits made between since and until,
+// oldest first. When author is not empty only matching commits are returned.
+func GetGitLog(since, until time.Time, author string) ([]GitCommit, error) {
+	args := []string{
+		"log",
+		"--no-merges",
+		"--reverse",
+		"--format=%H%x00%s%x00%cI",
+		"--since=" + since.Format(time.RFC3339),
+		"--until=" + until.Format(time.RFC3339),
+	}
+
+	if author != "" {
+		args = append(args, "--author="+author)
+	}
+
+	cmd := exec.Command("git", args...)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read git log: %w", err)
+	}
+
+	var commits []GitCommit
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if line == "" {
+			continue
+		}
+
+		commit, err := parseGitCommit(line)
+		if err != nil {
+			return nil, err
+		}
+
+		commits = append(commits, *commit)
+	}
+
+	return commits, nil
+}
@@ -0,0 +1,33 @@
+package project
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGitCommit(t *testing.T) {
+	t.Run("parses sha, subject and commit date", func(t *testing.T) {
+		commit, err := parseGitCommit("abcdef1234567890\x00Add login form\x002024-01-01T10:00:00+01:00")
+		require.NoError(t, err)
+		assert.Equal(t, "abcdef1234567890", commit.SHA)
+		assert.Equal(t, "Add login form", commit.Subject)
+		assert.True(t, commit.Time.Equal(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("rejects malformed output", func(t *testing.T) {
+		_, err := parseGitCommit("abcdef1234567890 Add login form")
+		assert.Error(t, err)
+
+		_, err = parseGitCommit("abcdef\x00subject\x00not-a-date")
+		assert.Error(t, err)
+	})
+}
+
+func TestGitCommitShortSHA(t *testing.T) {
+	assert.Equal(t, "abcdef1", GitCommit{SHA: "abcdef1234567890"}.ShortSHA())
+	assert.Equal(t, "abc", GitCommit{SHA: "abc"}.ShortSHA())
+	assert.Equal(t, "", GitCommit{}.ShortSHA())
+}
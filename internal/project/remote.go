@@ -0,0 +1,110 @@
+package project
+
+import (
+	"fmt"
+	"net/url"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/DylanDevelops/tmpo/internal/settings"
+)
+
+// scpLikeRemote matches remotes written as user@host:path (e.g. git@github.com:org/repo.git).
+var scpLikeRemote = regexp.MustCompile(`^(?:[^@/]+@)?([^:/]+):(.+)$`)
+
+// NormalizeRemoteURL reduces a git remote URL to a comparable host/path form
+// so that SSH, HTTPS and SCP-style URLs for the same repository are equal,
+// e.g. "git@github.com:Org/Repo.git" and "https://github.com/org/repo"
+// both become "github.com/org/repo". Local paths are returned cleaned.
+func NormalizeRemoteURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return ""
+	}
+
+	var host, repoPath string
+	if u, err := url.Parse(raw); err == nil && u.Scheme != "" && u.Host != "" {
+		host, repoPath = u.Hostname(), u.Path
+	} else if match := scpLikeRemote.FindStringSubmatch(raw); match != nil && !filepath.IsAbs(raw) {
+		host, repoPath = match[1], match[2]
+	} else {
+		return filepath.ToSlash(filepath.Clean(strings.TrimPrefix(raw, "file://")))
+	}
+
+	repoPath = strings.Trim(repoPath, "/")
+	repoPath = strings.TrimSuffix(repoPath, ".git")
+
+	return strings.ToLower(host + "/" + repoPath)
+}
+
+// GetGitRemoteURL returns the URL of the named remote.
+func GetGitRemoteURL(remote string) (string, error) {
+	cmd := exec.Command("git", "remote", "get-url", remote)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("remote '%s' not found", remote)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// GetMainWorktreeRoot returns the top-level directory of the main worktree,
+// which differs from GetGitRoot when run inside a linked worktree.
+func GetMainWorktreeRoot() (string, error) {
+	cmd := exec.Command("git", "rev-parse", "--path-format=absolute", "--git-common-dir")
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("not in a git repository")
+	}
+
+	commonDir := filepath.Clean(strings.TrimSpace(string(output)))
+	if filepath.Base(commonDir) != ".git" {
+		// bare repository
+		return commonDir, nil
+	}
+
+	return filepath.Dir(commonDir), nil
+}
+
+// DetectGitProject names the project for the current repository. A mapping
+// for the normalized origin URL in the global registry always wins. With
+// byRemote set, the repository name is taken from the origin URL (or the main
+// worktree when there is no origin) so every clone and worktree of a
+// repository is tracked as the same project; otherwise the top-level folder
+// name is used.
+func DetectGitProject(byRemote bool) (string, error) {
+	if remoteURL, err := GetGitRemoteURL("origin"); err == nil {
+		normalized := NormalizeRemoteURL(remoteURL)
+
+		if registry, err := settings.LoadProjects(); err == nil {
+			if projectName := projectForRemote(registry, normalized); projectName != "" {
+				return projectName, nil
+			}
+		}
+
+		if byRemote && normalized != "" {
+			return strings.TrimSuffix(path.Base(normalized), ".git"), nil
+		}
+	}
+
+	if byRemote {
+		if root, err := GetMainWorktreeRoot(); err == nil {
+			return strings.TrimSuffix(filepath.Base(root), ".git"), nil
+		}
+	}
+
+	return GetGitRepoName()
+}
+
+func projectForRemote(registry *settings.ProjectsRegistry, normalized string) string {
+	for _, mapping := range registry.Remotes {
+		if mapping.Project != "" && NormalizeRemoteURL(mapping.Remote) == normalized {
+			return mapping.Project
+		}
+	}
+
+	return ""
+}
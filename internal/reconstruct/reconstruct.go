@@ -0,0 +1,106 @@
+package reconstruct
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+)
+
+const (
+	// DefaultGap is the longest pause between two commits that still counts
+	// as the same working session.
+	DefaultGap = 2 * time.Hour
+
+	// DefaultLeadIn is the time assumed to have been spent before the first
+	// commit of a session.
+	DefaultLeadIn = 30 * time.Minute
+)
+
+// Session is a proposed time entry built from a run of commits.
+type Session struct {
+	Start   time.Time
+	End     time.Time
+	Commits []project.GitCommit
+
+	// Overlaps is set when the session overlaps an existing time entry.
+	Overlaps bool
+}
+
+func (s Session) Duration() time.Duration {
+	return s.End.Sub(s.Start)
+}
+
+// Description summarizes the session using its first commit subject.
+func (s Session) Description() string {
+	if len(s.Commits) == 0 {
+		return ""
+	}
+
+	description := s.Commits[0].Subject
+	if len(s.Commits) > 1 {
+		description = fmt.Sprintf("%s (+%d more)", description, len(s.Commits)-1)
+	}
+
+	return description
+}
+
+// Cluster groups commits into sessions. A new session starts whenever two
+// consecutive commits are more than gap apart. Each session starts leadIn
+// before its first commit and ends at its last commit.
+func Cluster(commits []project.GitCommit, gap, leadIn time.Duration) []Session {
+	sorted := make([]project.GitCommit, len(commits))
+	copy(sorted, commits)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+
+	var sessions []Session
+	for _, commit := range sorted {
+		if len(sessions) > 0 {
+			current := &sessions[len(sessions)-1]
+			if commit.Time.Sub(current.End) <= gap {
+				current.End = commit.Time
+				current.Commits = append(current.Commits, commit)
+				continue
+			}
+		}
+
+		sessions = append(sessions, Session{
+			Start:   commit.Time.Add(-leadIn),
+			End:     commit.Time,
+			Commits: []project.GitCommit{commit},
+		})
+	}
+
+	// the lead-in must not reach back into the previous session
+	for i := 1; i < len(sessions); i++ {
+		if sessions[i].Start.Before(sessions[i-1].End) {
+			sessions[i].Start = sessions[i-1].End
+		}
+	}
+
+	return sessions
+}
+
+// MarkOverlaps flags sessions that overlap any of the given entries, so
+// already tracked time is not recorded twice.
+func MarkOverlaps(sessions []Session, entries []*storage.TimeEntry) {
+	now := time.Now()
+
+	for i := range sessions {
+		for _, entry := range entries {
+			end := now
+			if entry.EndTime != nil {
+				end = *entry.EndTime
+			}
+
+			if sessions[i].Start.Before(end) && entry.StartTime.Before(sessions[i].End) {
+				sessions[i].Overlaps = true
+				break
+			}
+		}
+	}
+}
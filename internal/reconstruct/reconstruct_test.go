@@ -0,0 +1,75 @@
+package reconstruct
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/project"
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func commitAt(t time.Time, subject string) project.GitCommit {
+	return project.GitCommit{SHA: subject, Subject: subject, Time: t}
+}
+
+func TestCluster(t *testing.T) {
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	commits := []project.GitCommit{
+		// out of order on purpose
+		commitAt(day.Add(10*time.Hour+30*time.Minute), "second"),
+		commitAt(day.Add(9*time.Hour), "first"),
+		commitAt(day.Add(12*time.Hour), "third"),
+		commitAt(day.Add(16*time.Hour), "afternoon"),
+	}
+
+	sessions := Cluster(commits, 2*time.Hour, 30*time.Minute)
+	require.Len(t, sessions, 2)
+
+	morning := sessions[0]
+	assert.Equal(t, day.Add(8*time.Hour+30*time.Minute), morning.Start)
+	assert.Equal(t, day.Add(12*time.Hour), morning.End)
+	assert.Len(t, morning.Commits, 3)
+	assert.Equal(t, "first (+2 more)", morning.Description())
+
+	afternoon := sessions[1]
+	assert.Equal(t, day.Add(15*time.Hour+30*time.Minute), afternoon.Start)
+	assert.Equal(t, 30*time.Minute, afternoon.Duration())
+	assert.Equal(t, "afternoon", afternoon.Description())
+}
+
+func TestClusterLeadInDoesNotOverlapPreviousSession(t *testing.T) {
+	day := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	commits := []project.GitCommit{
+		commitAt(day, "a"),
+		commitAt(day.Add(45*time.Minute), "b"),
+	}
+
+	sessions := Cluster(commits, 30*time.Minute, time.Hour)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, sessions[0].End, sessions[1].Start)
+}
+
+func TestClusterEmpty(t *testing.T) {
+	assert.Empty(t, Cluster(nil, DefaultGap, DefaultLeadIn))
+}
+
+func TestMarkOverlaps(t *testing.T) {
+	day := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	sessions := []Session{
+		{Start: day.Add(9 * time.Hour), End: day.Add(11 * time.Hour)},
+		{Start: day.Add(13 * time.Hour), End: day.Add(14 * time.Hour)},
+	}
+
+	entryEnd := day.Add(10 * time.Hour)
+	entries := []*storage.TimeEntry{
+		{StartTime: day.Add(8 * time.Hour), EndTime: &entryEnd},
+	}
+
+	MarkOverlaps(sessions, entries)
+	assert.True(t, sessions[0].Overlaps)
+	assert.False(t, sessions[1].Overlaps)
+}
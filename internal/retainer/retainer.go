@@ -0,0 +1,190 @@
+package retainer
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+)
+
+// LowShare is the share of the latest top-up below which a balance is
+// running low.
+const LowShare = 0.2
+
+// Balance is a project's prepaid hours and the billable time drawn from them.
+type Balance struct {
+	Purchased float64
+	Used      float64
+	LastTopUp float64 // hours of the latest top-up
+}
+
+// New sums a project's top-ups and the billable hours drawn from them. It
+// returns nil when the project has no top-ups.
+func New(topUps []*storage.RetainerTopUp, entries []*storage.TimeEntry) *Balance {
+	if len(topUps) == 0 {
+		return nil
+	}
+
+	balance := &Balance{}
+	latest := topUps[0]
+	for _, topUp := range topUps {
+		balance.Purchased += topUp.Hours
+		if !topUp.Date.Before(latest.Date) {
+			latest = topUp
+		}
+	}
+	balance.LastTopUp = latest.Hours
+
+	for _, entry := range Draws(topUps, entries) {
+		balance.Used += entry.RoundedHours()
+	}
+
+	return balance
+}
+
+// Remaining returns the hours left, negative once the retainer is overdrawn.
+func (b *Balance) Remaining() float64 {
+	return b.Purchased - b.Used
+}
+
+// IsLow reports whether less than LowShare of the latest top-up is left.
+func (b *Balance) IsLow() bool {
+	return b.Remaining() < b.LastTopUp*LowShare
+}
+
+// String renders the balance as "12.5h of 30h left" or "2h overdrawn".
+func (b *Balance) String() string {
+	if remaining := b.Remaining(); remaining < 0 {
+		return fmt.Sprintf("%s overdrawn", FormatHours(-remaining))
+	}
+
+	return fmt.Sprintf("%s of %s left", FormatHours(b.Remaining()), FormatHours(b.Purchased))
+}
+
+// FormatHours renders hours rounded to 2 decimal places, e.g. "7.5h".
+func FormatHours(hours float64) string {
+	rounded := math.Round(hours*100) / 100
+	if rounded == 0 {
+		rounded = 0 // drop the sign of -0
+	}
+
+	return strconv.FormatFloat(rounded, 'f', -1, 64) + "h"
+}
+
+// Draws returns the billable entries that draw down a retainer, i.e. those
+// started on or after its first top-up.
+func Draws(topUps []*storage.RetainerTopUp, entries []*storage.TimeEntry) []*storage.TimeEntry {
+	if len(topUps) == 0 {
+		return nil
+	}
+
+	first := topUps[0].Date
+	for _, topUp := range topUps {
+		if topUp.Date.Before(first) {
+			first = topUp.Date
+		}
+	}
+
+	var draws []*storage.TimeEntry
+	for _, entry := range entries {
+		if entry.Billable && !entry.StartTime.Before(first) {
+			draws = append(draws, entry)
+		}
+	}
+
+	return draws
+}
+
+// LedgerLine is a top-up or an entry drawing down a retainer, with the
+// balance after it.
+type LedgerLine struct {
+	Date    time.Time
+	TopUp   *storage.RetainerTopUp // set for top-ups
+	Entry   *storage.TimeEntry     // set for tracked time
+	Hours   float64                // positive for top-ups, negative for tracked time
+	Balance float64
+}
+
+// Ledger lists a project's top-ups and the entries drawn from them in
+// chronological order with the running balance.
+func Ledger(topUps []*storage.RetainerTopUp, entries []*storage.TimeEntry) []LedgerLine {
+	var lines []LedgerLine
+	for _, topUp := range topUps {
+		lines = append(lines, LedgerLine{Date: topUp.Date, TopUp: topUp, Hours: topUp.Hours})
+	}
+	for _, entry := range Draws(topUps, entries) {
+		lines = append(lines, LedgerLine{Date: entry.StartTime, Entry: entry, Hours: -entry.RoundedHours()})
+	}
+
+	// top-ups come before time tracked at the same moment
+	sort.SliceStable(lines, func(i, j int) bool {
+		if !lines[i].Date.Equal(lines[j].Date) {
+			return lines[i].Date.Before(lines[j].Date)
+		}
+		return lines[i].TopUp != nil && lines[j].TopUp == nil
+	})
+
+	balance := 0.0
+	for i := range lines {
+		balance += lines[i].Hours
+		lines[i].Balance = balance
+	}
+
+	return lines
+}
+
+// Load returns the retainer balance of a project, or nil when it has no
+// top-ups.
+func Load(db *storage.Database, projectName string) (*Balance, error) {
+	topUps, err := db.GetRetainerTopUps(projectName)
+	if err != nil || len(topUps) == 0 {
+		return nil, err
+	}
+
+	entries, err := db.GetEntriesByProject(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(topUps, entries), nil
+}
+
+// LoadAll returns the retainer balance of every project with top-ups.
+func LoadAll(db *storage.Database) (map[string]*Balance, error) {
+	topUps, err := db.GetRetainerTopUps("")
+	if err != nil {
+		return nil, err
+	}
+
+	byProject := make(map[string][]*storage.RetainerTopUp)
+	for _, topUp := range topUps {
+		byProject[topUp.ProjectName] = append(byProject[topUp.ProjectName], topUp)
+	}
+
+	balances := make(map[string]*Balance, len(byProject))
+	for projectName, projectTopUps := range byProject {
+		entries, err := db.GetEntriesByProject(projectName)
+		if err != nil {
+			return nil, err
+		}
+		balances[projectName] = New(projectTopUps, entries)
+	}
+
+	return balances, nil
+}
+
+// Warning describes a low or overdrawn balance, or returns "" while enough
+// hours are left.
+func (b *Balance) Warning(projectName string) string {
+	if remaining := b.Remaining(); remaining < 0 {
+		return fmt.Sprintf("Retainer for %s is overdrawn by %s", projectName, FormatHours(-remaining))
+	}
+	if b.IsLow() {
+		return fmt.Sprintf("Retainer for %s is running low: %s", projectName, b)
+	}
+
+	return ""
+}
@@ -0,0 +1,81 @@
+package retainer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DylanDevelops/tmpo/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func entry(start time.Time, hours float64, billable bool) *storage.TimeEntry {
+	end := start.Add(time.Duration(hours * float64(time.Hour)))
+	return &storage.TimeEntry{ProjectName: "web", StartTime: start, EndTime: &end, Billable: billable}
+}
+
+func TestNew(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	topUps := []*storage.RetainerTopUp{
+		{ID: 1, Date: jan, Hours: 20},
+		{ID: 2, Date: jan.AddDate(0, 1, 0), Hours: 10},
+	}
+	entries := []*storage.TimeEntry{
+		entry(jan.AddDate(0, 0, -1), 5, true), // before the first top-up
+		entry(jan.Add(9*time.Hour), 8, true),
+		entry(jan.AddDate(0, 0, 1).Add(9*time.Hour), 4, false),
+		entry(jan.AddDate(0, 0, 2).Add(9*time.Hour), 12.5, true),
+	}
+
+	balance := New(topUps, entries)
+	require.NotNil(t, balance)
+	assert.Equal(t, 30.0, balance.Purchased)
+	assert.Equal(t, 20.5, balance.Used)
+	assert.Equal(t, 9.5, balance.Remaining())
+	assert.False(t, balance.IsLow())
+	assert.Equal(t, "9.5h of 30h left", balance.String())
+
+	assert.Empty(t, balance.Warning("web"))
+
+	balance.Used = 29
+	assert.True(t, balance.IsLow())
+	assert.Equal(t, "Retainer for web is running low: 1h of 30h left", balance.Warning("web"))
+
+	balance.Used = 32
+	assert.Equal(t, "2h overdrawn", balance.String())
+	assert.Equal(t, "Retainer for web is overdrawn by 2h", balance.Warning("web"))
+
+	assert.Nil(t, New(nil, entries))
+}
+
+func TestLedger(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	topUps := []*storage.RetainerTopUp{
+		{ID: 1, Date: jan, Hours: 10},
+		{ID: 2, Date: jan.AddDate(0, 0, 2), Hours: 5},
+	}
+	entries := []*storage.TimeEntry{
+		entry(jan.AddDate(0, 0, 3), 2, true),
+		entry(jan, 4, true),
+		entry(jan.AddDate(0, 0, 1), 8, true),
+	}
+
+	lines := Ledger(topUps, entries)
+	require.Len(t, lines, 5)
+
+	var hours, balances []float64
+	for _, line := range lines {
+		hours = append(hours, line.Hours)
+		balances = append(balances, line.Balance)
+	}
+	assert.Equal(t, []float64{10, -4, -8, 5, -2}, hours)
+	assert.Equal(t, []float64{10, 6, -2, 3, 1}, balances)
+	assert.NotNil(t, lines[0].TopUp)
+	assert.NotNil(t, lines[1].Entry)
+}
+
+func TestFormatHours(t *testing.T) {
+	assert.Equal(t, "7.5h", FormatHours(7.5))
+	assert.Equal(t, "0.33h", FormatHours(1.0/3))
+	assert.Equal(t, "0h", FormatHours(-0.001))
+}
@@ -1,6 +1,7 @@
 package settings
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -10,10 +11,28 @@ import (
 
 // IMPORTANT: When adding new fields to this struct, also update configTemplate below.
 type Config struct {
-	ProjectName string `yaml:"project_name"`
-	HourlyRate float64 `yaml:"hourly_rate,omitempty"`
-	Description string `yaml:"description,omitempty"`
-	ExportPath  string `yaml:"export_path,omitempty"`
+	ProjectName       string            `yaml:"project_name"`
+	HourlyRate        float64           `yaml:"hourly_rate,omitempty"`
+	Currency          string            `yaml:"currency,omitempty"`
+	TaxRate           *float64          `yaml:"tax_rate,omitempty"`
+	Discount          float64           `yaml:"discount,omitempty"`
+	Billable          *bool             `yaml:"billable,omitempty"`
+	Description       string            `yaml:"description,omitempty"`
+	ExportPath        string            `yaml:"export_path,omitempty"`
+	DailyGoalHours    float64           `yaml:"daily_goal_hours,omitempty"`
+	WeeklyGoalHours   float64           `yaml:"weekly_goal_hours,omitempty"`
+	BranchDescription bool              `yaml:"branch_description,omitempty"`
+	BranchPattern     string            `yaml:"branch_pattern,omitempty"`
+	BranchMilestones  []BranchMilestone `yaml:"branch_milestones,omitempty"`
+	Inherit           bool              `yaml:"inherit,omitempty"`
+	Paths             []PathRule        `yaml:"paths,omitempty"`
+}
+
+// BranchMilestone maps git branches matching Pattern (a glob such as
+// "release/*") to the milestone new entries should be assigned to.
+type BranchMilestone struct {
+	Pattern   string `yaml:"pattern"`
+	Milestone string `yaml:"milestone"`
 }
 
 // IMPORTANT: When adding new fields to Config, update this template.
@@ -26,11 +45,48 @@ project_name: %s
 # [OPTIONAL] Hourly rate for billing calculations (set to 0 to disable)
 hourly_rate: %.2f
 
+# [OPTIONAL] Currency the hourly rate is in, if it differs from the global
+# currency set with 'tmpo config' (uncomment to enable)
+# currency: EUR
+
+# [OPTIONAL] Tax (VAT/GST) and discount as percentages, shown as net, tax and
+# gross amounts in stats and exports (uncomment to enable)
+# tax_rate: 19
+# discount: 10
+
+# [OPTIONAL] Whether new entries are billable by default; non-billable time
+# doesn't count towards earnings (uncomment to track non-billable by default)
+# billable: false
+
 # [OPTIONAL] Description for this project
 description: "%s"
 
 # [OPTIONAL] Default export path for this project (overrides global export path)
 export_path: "%s"
+
+# [OPTIONAL] Working-hours goals for this project (uncomment to enable)
+# daily_goal_hours: 6
+# weekly_goal_hours: 30
+
+# [OPTIONAL] Derive the description and issue key from the git branch when
+# 'tmpo start' is run without a description (uncomment to enable)
+# branch_description: true
+# branch_pattern: '^(?:[^/]+/)*(?P<issue>[A-Za-z][A-Za-z0-9]+-\d+)?[-_]*(?P<description>.*)$'
+
+# [OPTIONAL] Assign entries to a milestone based on the git branch
+# branch_milestones:
+#   - pattern: "release/*"
+#     milestone: "Release"
+
+# [OPTIONAL] Map subdirectories to projects, e.g. in a monorepo. Patterns are
+# relative to this file; the first matching rule wins
+# paths:
+#   - pattern: "services/billing/**"
+#     project: "billing"
+
+# [OPTIONAL] Inherit settings from the nearest parent .tmporc; fields set in
+# this file override the parent's (uncomment to enable)
+# inherit: true
 `
 
 func Load(path string) (*Config, error) {
@@ -63,7 +119,7 @@ func (c *Config) Save(path string) error {
 func Create(projectName string, hourlyRate float64) error {
 	config := &Config{
 		ProjectName: projectName,
-		HourlyRate: hourlyRate,
+		HourlyRate:  hourlyRate,
 	}
 
 	tmporc := filepath.Join(".", ".tmporc")
@@ -89,18 +145,127 @@ func CreateWithTemplate(projectName string, hourlyRate float64, description stri
 	return nil
 }
 
+// FindAndLoad finds the nearest .tmporc walking up from the current directory.
+// When that file sets `inherit: true`, parent .tmporc files are merged in
+// beneath it, and each file's path rules are applied to the current directory.
+// The returned path is always the nearest file.
 func FindAndLoad() (*Config, string, error) {
 	dir, err := os.Getwd()
 	if err != nil {
 		return nil, "", err
 	}
 
+	chain, err := findTmporcChain(dir)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(chain) == 0 {
+		return nil, "", fmt.Errorf(".tmporc not found")
+	}
+
+	config, err := loadChain(chain, dir)
+
+	return config, chain[0], err
+}
+
+// FindConfigChain returns the .tmporc files FindAndLoad merges for the current
+// directory, nearest first.
+func FindConfigChain() ([]string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	return findTmporcChain(dir)
+}
+
+// RenameProjectInConfig replaces oldName with newName in the project_name and
+// paths rules of the .tmporc at path, keeping the rest of the file (including
+// comments) intact. It reports whether anything was changed.
+func RenameProjectInConfig(path, oldName, newName string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return false, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return false, nil
+	}
+
+	changed := false
+	root := doc.Content[0]
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+
+		switch key.Value {
+		case "project_name":
+			if value.Kind == yaml.ScalarNode && value.Value == oldName {
+				value.Value = newName
+				changed = true
+			}
+		case "paths":
+			if value.Kind != yaml.SequenceNode {
+				continue
+			}
+			for _, rule := range value.Content {
+				if rule.Kind != yaml.MappingNode {
+					continue
+				}
+				for j := 0; j+1 < len(rule.Content); j += 2 {
+					if rule.Content[j].Value == "project" && rule.Content[j+1].Value == oldName {
+						rule.Content[j+1].Value = newName
+						changed = true
+					}
+				}
+			}
+		}
+	}
+
+	if !changed {
+		return false, nil
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return false, fmt.Errorf("failed to marshal config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return false, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return false, fmt.Errorf("failed to write config: %w", err)
+	}
+
+	return true, nil
+}
+
+// findTmporcChain returns the .tmporc files that apply to dir, nearest first.
+// The walk stops at the first file that doesn't inherit from its parent.
+func findTmporcChain(dir string) ([]string, error) {
+	var chain []string
+
 	for {
 		tmporc := filepath.Join(dir, ".tmporc")
 		if _, err := os.Stat(tmporc); err == nil {
+			chain = append(chain, tmporc)
+
 			config, err := Load(tmporc)
+			if err != nil {
+				return nil, err
+			}
 
-			return config, tmporc, err
+			if !config.Inherit {
+				break
+			}
 		}
 
 		parent := filepath.Dir(dir)
@@ -111,5 +276,33 @@ func FindAndLoad() (*Config, string, error) {
 		dir = parent
 	}
 
-	return nil, "", fmt.Errorf(".tmporc not found")
+	return chain, nil
+}
+
+// loadChain merges the files of a .tmporc chain (nearest first) from the
+// outermost in, so a nested file only overrides the fields it sets.
+func loadChain(chain []string, cwd string) (*Config, error) {
+	var config Config
+
+	for i := len(chain) - 1; i >= 0; i-- {
+		data, err := os.ReadFile(chain[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config: %w", err)
+		}
+
+		var own Config
+		if err := yaml.Unmarshal(data, &own); err != nil {
+			return nil, fmt.Errorf("failed to parse config %s: %w", chain[i], err)
+		}
+
+		if err := yaml.Unmarshal(data, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse config %s: %w", chain[i], err)
+		}
+
+		if project := MatchPathRules(own.Paths, filepath.Dir(chain[i]), cwd); project != "" {
+			config.ProjectName = project
+		}
+	}
+
+	return &config, nil
 }
@@ -330,4 +330,151 @@ func TestFindAndLoad(t *testing.T) {
 		actualPath, _ := filepath.EvalSymlinks(path)
 		assert.Equal(t, expectedPath, actualPath)
 	})
+
+	t.Run("nested config inherits unset fields from parent", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		originalDir, err := os.Getwd()
+		assert.NoError(t, err)
+		defer os.Chdir(originalDir)
+
+		err = os.WriteFile(filepath.Join(tmpDir, ".tmporc"), []byte("project_name: monorepo\nhourly_rate: 120\nexport_path: /exports\n"), 0644)
+		assert.NoError(t, err)
+
+		subDir := filepath.Join(tmpDir, "services", "search")
+		err = os.MkdirAll(subDir, 0755)
+		assert.NoError(t, err)
+		subConfig := filepath.Join(subDir, ".tmporc")
+		err = os.WriteFile(subConfig, []byte("inherit: true\nproject_name: search\nhourly_rate: 90\n"), 0644)
+		assert.NoError(t, err)
+
+		err = os.Chdir(subDir)
+		assert.NoError(t, err)
+
+		found, path, err := FindAndLoad()
+		assert.NoError(t, err)
+		assert.Equal(t, "search", found.ProjectName)
+		assert.Equal(t, 90.0, found.HourlyRate)
+		assert.Equal(t, "/exports", found.ExportPath)
+
+		expectedPath, _ := filepath.EvalSymlinks(subConfig)
+		actualPath, _ := filepath.EvalSymlinks(path)
+		assert.Equal(t, expectedPath, actualPath)
+	})
+
+	t.Run("applies path rules from root config", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		originalDir, err := os.Getwd()
+		assert.NoError(t, err)
+		defer os.Chdir(originalDir)
+
+		cfg := &Config{
+			ProjectName: "monorepo",
+			HourlyRate:  100,
+			Paths: []PathRule{
+				{Pattern: "services/billing/**", Project: "billing"},
+				{Pattern: "apps/*", Project: "frontend"},
+			},
+		}
+		err = cfg.Save(filepath.Join(tmpDir, ".tmporc"))
+		assert.NoError(t, err)
+
+		for _, dir := range []string{"services/billing/api", "apps/web/src", "docs"} {
+			err = os.MkdirAll(filepath.Join(tmpDir, dir), 0755)
+			assert.NoError(t, err)
+		}
+
+		err = os.Chdir(filepath.Join(tmpDir, "services", "billing", "api"))
+		assert.NoError(t, err)
+		found, _, err := FindAndLoad()
+		assert.NoError(t, err)
+		assert.Equal(t, "billing", found.ProjectName)
+		assert.Equal(t, 100.0, found.HourlyRate)
+
+		err = os.Chdir(filepath.Join(tmpDir, "apps", "web", "src"))
+		assert.NoError(t, err)
+		found, _, err = FindAndLoad()
+		assert.NoError(t, err)
+		assert.Equal(t, "frontend", found.ProjectName)
+
+		err = os.Chdir(filepath.Join(tmpDir, "docs"))
+		assert.NoError(t, err)
+		found, _, err = FindAndLoad()
+		assert.NoError(t, err)
+		assert.Equal(t, "monorepo", found.ProjectName)
+	})
+
+	t.Run("nested config without inherit ignores parent rules", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		originalDir, err := os.Getwd()
+		assert.NoError(t, err)
+		defer os.Chdir(originalDir)
+
+		cfg := &Config{ProjectName: "monorepo", HourlyRate: 100, Paths: []PathRule{{Pattern: "services/**", Project: "services"}}}
+		err = cfg.Save(filepath.Join(tmpDir, ".tmporc"))
+		assert.NoError(t, err)
+
+		subDir := filepath.Join(tmpDir, "services", "legacy")
+		err = os.MkdirAll(subDir, 0755)
+		assert.NoError(t, err)
+		cfg = &Config{ProjectName: "legacy"}
+		err = cfg.Save(filepath.Join(subDir, ".tmporc"))
+		assert.NoError(t, err)
+
+		err = os.Chdir(subDir)
+		assert.NoError(t, err)
+
+		found, _, err := FindAndLoad()
+		assert.NoError(t, err)
+		assert.Equal(t, "legacy", found.ProjectName)
+		assert.Equal(t, 0.0, found.HourlyRate)
+	})
+}
+
+func TestRenameProjectInConfig(t *testing.T) {
+	t.Run("renames project and path rules keeping comments", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), ".tmporc")
+		content := `# tmpo project configuration
+project_name: api
+hourly_rate: 100
+# sub-projects
+paths:
+  - pattern: "services/api/**"
+    project: api
+  - pattern: "web/**"
+    project: web
+`
+		err := os.WriteFile(configPath, []byte(content), 0644)
+		assert.NoError(t, err)
+
+		changed, err := RenameProjectInConfig(configPath, "api", "api-server")
+		assert.NoError(t, err)
+		assert.True(t, changed)
+
+		data, err := os.ReadFile(configPath)
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "# tmpo project configuration")
+		assert.Contains(t, string(data), "# sub-projects")
+
+		cfg, err := Load(configPath)
+		assert.NoError(t, err)
+		assert.Equal(t, "api-server", cfg.ProjectName)
+		assert.Equal(t, 100.0, cfg.HourlyRate)
+		assert.Equal(t, "api-server", cfg.Paths[0].Project)
+		assert.Equal(t, "web", cfg.Paths[1].Project)
+	})
+
+	t.Run("leaves unrelated configs untouched", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), ".tmporc")
+		content := "project_name: other\n"
+		err := os.WriteFile(configPath, []byte(content), 0644)
+		assert.NoError(t, err)
+
+		changed, err := RenameProjectInConfig(configPath, "api", "api-server")
+		assert.NoError(t, err)
+		assert.False(t, changed)
+
+		data, err := os.ReadFile(configPath)
+		assert.NoError(t, err)
+		assert.Equal(t, content, string(data))
+	})
 }
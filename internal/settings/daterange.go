@@ -0,0 +1,87 @@
+package settings
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ParseDate parses a date typed on the command line in the display timezone.
+// ISO dates are always accepted, as well as the configured date layout.
+func ParseDate(input, layout string) (time.Time, error) {
+	for _, candidate := range []string{"2006-01-02", layout} {
+		if date, err := time.ParseInLocation(candidate, input, GetDisplayTimezone()); err == nil {
+			return date, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("use YYYY-MM-DD")
+}
+
+// ParseDateRange parses a range of days relative to now: a keyword (today,
+// yesterday, week, last-week, month, last-month), a single date, or FROM..TO
+// where both dates are inclusive and either can be left out. The returned end
+// is exclusive; a nil bound is open.
+func ParseDateRange(input, layout string, now time.Time) (*time.Time, *time.Time, error) {
+	now = now.In(GetDisplayTimezone())
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	weekday := int(today.Weekday())
+	if weekday == 0 {
+		weekday = 7 // sunday
+	}
+	weekStart := today.AddDate(0, 0, -weekday+1)
+	monthStart := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location())
+
+	span := func(start, end time.Time) (*time.Time, *time.Time, error) {
+		return &start, &end, nil
+	}
+
+	switch strings.ToLower(strings.TrimSpace(input)) {
+	case "today":
+		return span(today, today.AddDate(0, 0, 1))
+	case "yesterday":
+		return span(today.AddDate(0, 0, -1), today)
+	case "week", "this-week":
+		return span(weekStart, weekStart.AddDate(0, 0, 7))
+	case "last-week":
+		return span(weekStart.AddDate(0, 0, -7), weekStart)
+	case "month", "this-month":
+		return span(monthStart, monthStart.AddDate(0, 1, 0))
+	case "last-month":
+		return span(monthStart.AddDate(0, -1, 0), monthStart)
+	}
+
+	from, to, isSpan := strings.Cut(input, "..")
+	if !isSpan {
+		to = from
+	}
+
+	var start, end *time.Time
+	if from = strings.TrimSpace(from); from != "" {
+		date, err := ParseDate(from, layout)
+		if err != nil {
+			return nil, nil, err
+		}
+		start = &date
+	}
+
+	if to = strings.TrimSpace(to); to != "" {
+		date, err := ParseDate(to, layout)
+		if err != nil {
+			return nil, nil, err
+		}
+		next := date.AddDate(0, 0, 1)
+		end = &next
+	}
+
+	if start == nil && end == nil {
+		return nil, nil, fmt.Errorf("use a keyword, a date or FROM..TO")
+	}
+
+	if start != nil && end != nil && !end.After(*start) {
+		return nil, nil, fmt.Errorf("the range ends before it starts")
+	}
+
+	return start, end, nil
+}
@@ -0,0 +1,83 @@
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDate(t *testing.T) {
+	date, err := ParseDate("2026-10-01", "01-02-2006")
+	require.NoError(t, err)
+	assert.Equal(t, 2026, date.Year())
+	assert.Equal(t, 10, int(date.Month()))
+	assert.Equal(t, 1, date.Day())
+	assert.Equal(t, 0, date.Hour())
+
+	date, err = ParseDate("10-05-2026", "01-02-2006")
+	require.NoError(t, err)
+	assert.Equal(t, 5, date.Day())
+
+	_, err = ParseDate("yesterday", "01-02-2006")
+	assert.Error(t, err)
+}
+
+func TestParseDateRange(t *testing.T) {
+	// a Wednesday
+	now := time.Date(2024, 3, 6, 15, 0, 0, 0, time.Local)
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2024, month, d, 0, 0, 0, 0, time.Local)
+	}
+
+	tests := []struct {
+		name      string
+		input     string
+		wantStart *time.Time
+		wantEnd   *time.Time
+		wantErr   bool
+	}{
+		{name: "today", input: "today", wantStart: ptr(day(3, 6)), wantEnd: ptr(day(3, 7))},
+		{name: "this week", input: "week", wantStart: ptr(day(3, 4)), wantEnd: ptr(day(3, 11))},
+		{name: "last week", input: "last-week", wantStart: ptr(day(2, 26)), wantEnd: ptr(day(3, 4))},
+		{name: "last month", input: "last-month", wantStart: ptr(day(2, 1)), wantEnd: ptr(day(3, 1))},
+		{name: "single date", input: "2024-03-01", wantStart: ptr(day(3, 1)), wantEnd: ptr(day(3, 2))},
+		{name: "span is inclusive", input: "2024-03-01..03-03-2024", wantStart: ptr(day(3, 1)), wantEnd: ptr(day(3, 4))},
+		{name: "open end", input: "2024-03-01..", wantStart: ptr(day(3, 1))},
+		{name: "open start", input: "..2024-03-01", wantEnd: ptr(day(3, 2))},
+		{name: "reversed", input: "2024-03-05..2024-03-01", wantErr: true},
+		{name: "invalid date", input: "soon", wantErr: true},
+		{name: "empty span", input: "..", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := ParseDateRange(tt.input, "01-02-2006", now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assertTimePtr(t, tt.wantStart, start)
+			assertTimePtr(t, tt.wantEnd, end)
+		})
+	}
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
+
+func assertTimePtr(t *testing.T, want, got *time.Time) {
+	t.Helper()
+
+	if want == nil {
+		assert.Nil(t, got)
+		return
+	}
+
+	require.NotNil(t, got)
+	assert.True(t, want.Equal(*got), "want %s, got %s", want, got)
+}
@@ -11,11 +11,33 @@ import (
 )
 
 type GlobalConfig struct {
-	Currency   string `yaml:"currency"`
-	DateFormat string `yaml:"date_format,omitempty"`
-	TimeFormat string `yaml:"time_format,omitempty"`
-	Timezone   string `yaml:"timezone,omitempty"`
-	ExportPath string `yaml:"export_path,omitempty"`
+	Currency           string         `yaml:"currency"`
+	ReportingCurrency  string         `yaml:"reporting_currency,omitempty"`
+	TaxRate            float64        `yaml:"tax_rate,omitempty"`
+	Locale             string         `yaml:"locale,omitempty"`
+	DecimalSeparator   string         `yaml:"decimal_separator,omitempty"`
+	ThousandsSeparator string         `yaml:"thousands_separator,omitempty"`
+	SymbolPosition     string         `yaml:"symbol_position,omitempty"`
+	DateFormat         string         `yaml:"date_format,omitempty"`
+	TimeFormat         string         `yaml:"time_format,omitempty"`
+	Timezone           string         `yaml:"timezone,omitempty"`
+	ExportPath         string         `yaml:"export_path,omitempty"`
+	DailyGoalHours     float64        `yaml:"daily_goal_hours,omitempty"`
+	WeeklyGoalHours    float64        `yaml:"weekly_goal_hours,omitempty"`
+	Pomodoro           PomodoroConfig `yaml:"pomodoro,omitempty"`
+	ProjectPaths       []PathRule     `yaml:"project_paths,omitempty"`
+	DetectByGitRemote  bool           `yaml:"detect_by_git_remote,omitempty"`
+}
+
+// PomodoroConfig holds the defaults for `tmpo pomodoro`. Zero values fall
+// back to the built-in defaults (25/5/15 minutes, long break every 4 rounds).
+type PomodoroConfig struct {
+	FocusMinutes      int    `yaml:"focus_minutes,omitempty"`
+	ShortBreakMinutes int    `yaml:"short_break_minutes,omitempty"`
+	LongBreakMinutes  int    `yaml:"long_break_minutes,omitempty"`
+	LongBreakEvery    int    `yaml:"long_break_every,omitempty"`
+	Hook              string `yaml:"hook,omitempty"`
+	DisableBell       bool   `yaml:"disable_bell,omitempty"`
 }
 
 func DefaultGlobalConfig() *GlobalConfig {
@@ -92,6 +114,64 @@ func (gc *GlobalConfig) Save() error {
 	return nil
 }
 
+// GetReportingCurrency returns the currency stats convert earnings to, which
+// is the default currency unless configured otherwise.
+func (gc *GlobalConfig) GetReportingCurrency() string {
+	if gc.ReportingCurrency != "" {
+		return gc.ReportingCurrency
+	}
+
+	return gc.Currency
+}
+
+// CurrencyFormat returns how amounts are formatted: the locale's number
+// format if one is set, with any separator or symbol position configured on
+// top of it. A thousands separator of "none" disables grouping.
+func (gc *GlobalConfig) CurrencyFormat() currency.Format {
+	format := currency.DefaultFormat
+	if locale, ok := currency.LocaleFormat(gc.Locale); ok {
+		format = locale
+	}
+
+	if gc.DecimalSeparator != "" {
+		format.DecimalSeparator = gc.DecimalSeparator
+	}
+
+	switch gc.ThousandsSeparator {
+	case "":
+	case "none":
+		format.ThousandsSeparator = ""
+	default:
+		format.ThousandsSeparator = gc.ThousandsSeparator
+	}
+
+	switch gc.SymbolPosition {
+	case "before":
+		format.SymbolAfter = false
+	case "after":
+		format.SymbolAfter = true
+	}
+
+	return format
+}
+
+// GetCurrencyFormat returns the configured currency format, or the default
+// format if the config can't be loaded. Load it once when formatting many
+// amounts.
+func GetCurrencyFormat() currency.Format {
+	cfg, err := LoadGlobalConfig()
+	if err != nil {
+		return currency.DefaultFormat
+	}
+
+	return cfg.CurrencyFormat()
+}
+
+// FormatCurrency formats an amount in the configured currency format.
+func FormatCurrency(amount float64, currencyCode string) string {
+	return GetCurrencyFormat().FormatCurrency(amount, currencyCode)
+}
+
 // GetDisplayTimezone returns the user's configured timezone or local timezone as fallback
 func GetDisplayTimezone() *time.Location {
 	cfg, err := LoadGlobalConfig()
@@ -163,22 +243,24 @@ func FormatDate(t time.Time) string {
 }
 
 func FormatDateDashed(t time.Time) string {
-	t = toDisplayTime(t)
+	return toDisplayTime(t).Format(DateLayoutDashed())
+}
 
+// DateLayoutDashed returns the time layout for dates in the configured
+// format, with dashes instead of slashes as used when typing dates.
+func DateLayoutDashed() string {
 	cfg, err := LoadGlobalConfig()
-	if err != nil || cfg.DateFormat == "" || cfg.DateFormat == "Keep current" {
-		return t.Format("01-02-2006")
+	if err != nil {
+		return "01-02-2006"
 	}
 
 	switch cfg.DateFormat {
-	case "MM/DD/YYYY":
-		return t.Format("01-02-2006")
 	case "DD/MM/YYYY":
-		return t.Format("02-01-2006")
+		return "02-01-2006"
 	case "YYYY-MM-DD":
-		return t.Format("2006-01-02")
+		return "2006-01-02"
 	default:
-		return t.Format("01-02-2006")
+		return "01-02-2006"
 	}
 }
 
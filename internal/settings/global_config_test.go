@@ -0,0 +1,48 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/DylanDevelops/tmpo/internal/currency"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrencyFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   GlobalConfig
+		expected currency.Format
+	}{
+		{
+			name:     "default",
+			config:   GlobalConfig{},
+			expected: currency.DefaultFormat,
+		},
+		{
+			name:     "locale",
+			config:   GlobalConfig{Locale: "de-DE"},
+			expected: currency.Format{DecimalSeparator: ",", ThousandsSeparator: ".", SymbolAfter: true},
+		},
+		{
+			name:     "overrides on top of locale",
+			config:   GlobalConfig{Locale: "de-DE", ThousandsSeparator: "none", SymbolPosition: "before"},
+			expected: currency.Format{DecimalSeparator: ","},
+		},
+		{
+			name:     "separators without locale",
+			config:   GlobalConfig{DecimalSeparator: ",", ThousandsSeparator: " ", SymbolPosition: "after"},
+			expected: currency.Format{DecimalSeparator: ",", ThousandsSeparator: " ", SymbolAfter: true},
+		},
+		{
+			name:     "unknown locale",
+			config:   GlobalConfig{Locale: "xx-XX"},
+			expected: currency.DefaultFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.config.CurrencyFormat())
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package settings
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// PathRule maps directories matching Pattern to a project. Patterns use
+// slash-separated globs where "*" matches within one path segment and "**"
+// matches any number of segments. A pattern also matches everything below
+// the directories it matches, so "services/billing" and "services/billing/**"
+// are equivalent.
+type PathRule struct {
+	Pattern string `yaml:"pattern"`
+	Project string `yaml:"project"`
+}
+
+// MatchPathRules returns the project of the first rule matching dir, or "" if
+// none match. Relative patterns are resolved against baseDir; absolute and
+// "~/" patterns are matched as-is.
+func MatchPathRules(rules []PathRule, baseDir string, dir string) string {
+	for _, rule := range rules {
+		if rule.Pattern == "" || rule.Project == "" {
+			continue
+		}
+
+		pattern := expandHome(rule.Pattern)
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(baseDir, pattern)
+		}
+
+		if matchPathPattern(filepath.ToSlash(filepath.Clean(pattern)), filepath.ToSlash(filepath.Clean(dir))) {
+			return rule.Project
+		}
+	}
+
+	return ""
+}
+
+// ProjectForPath returns the project the global project_paths rules assign to
+// dir, or "" if none match.
+func (gc *GlobalConfig) ProjectForPath(dir string) string {
+	return MatchPathRules(gc.ProjectPaths, "", dir)
+}
+
+// RenameProject points the project_paths rules for oldName at newName and
+// reports whether any rule changed.
+func (gc *GlobalConfig) RenameProject(oldName, newName string) bool {
+	changed := false
+	for i := range gc.ProjectPaths {
+		if strings.EqualFold(gc.ProjectPaths[i].Project, oldName) {
+			gc.ProjectPaths[i].Project = newName
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+func expandHome(pattern string) string {
+	if pattern != "~" && !strings.HasPrefix(pattern, "~/") {
+		return pattern
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return pattern
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(pattern, "~"))
+}
+
+func matchPathPattern(pattern, target string) bool {
+	patternSegments := splitPath(pattern)
+	if len(patternSegments) == 0 || patternSegments[len(patternSegments)-1] != "**" {
+		patternSegments = append(patternSegments, "**")
+	}
+
+	return matchSegments(patternSegments, splitPath(target))
+}
+
+func matchSegments(pattern, target []string) bool {
+	if len(pattern) == 0 {
+		return len(target) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(target); i++ {
+			if matchSegments(pattern[1:], target[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(target) == 0 {
+		return false
+	}
+
+	if ok, err := path.Match(pattern[0], target[0]); err != nil || !ok {
+		return false
+	}
+
+	return matchSegments(pattern[1:], target[1:])
+}
+
+func splitPath(p string) []string {
+	var segments []string
+	for _, segment := range strings.Split(p, "/") {
+		if segment != "" && segment != "." {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
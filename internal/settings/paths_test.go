@@ -0,0 +1,68 @@
+package settings
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchPathRules(t *testing.T) {
+	base := filepath.FromSlash("/work/monorepo")
+	rules := []PathRule{
+		{Pattern: "services/billing/**", Project: "billing"},
+		{Pattern: "services/*/web", Project: "web"},
+		{Pattern: "tools", Project: "tools"},
+		{Pattern: "**/vendor", Project: "vendored"},
+	}
+
+	tests := []struct {
+		dir  string
+		want string
+	}{
+		{"/work/monorepo/services/billing", "billing"},
+		{"/work/monorepo/services/billing/api/v2", "billing"},
+		{"/work/monorepo/services/search/web", "web"},
+		{"/work/monorepo/services/search/web/src", "web"},
+		{"/work/monorepo/services/search", ""},
+		{"/work/monorepo/tools/lint", "tools"},
+		{"/work/monorepo/toolsmith", ""},
+		{"/work/monorepo/services/search/vendor", "vendored"},
+		{"/work/monorepo", ""},
+		{"/elsewhere/services/billing", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchPathRules(rules, base, filepath.FromSlash(tt.dir)))
+		})
+	}
+}
+
+func TestMatchPathRulesFirstMatchWins(t *testing.T) {
+	rules := []PathRule{
+		{Pattern: "services/billing", Project: "billing"},
+		{Pattern: "services", Project: "services"},
+	}
+
+	base := filepath.FromSlash("/repo")
+	assert.Equal(t, "billing", MatchPathRules(rules, base, filepath.FromSlash("/repo/services/billing/api")))
+	assert.Equal(t, "services", MatchPathRules(rules, base, filepath.FromSlash("/repo/services/search")))
+}
+
+func TestGlobalConfigProjectForPath(t *testing.T) {
+	home, err := os.UserHomeDir()
+	assert.NoError(t, err)
+
+	cfg := &GlobalConfig{
+		ProjectPaths: []PathRule{
+			{Pattern: "~/code/acme/**", Project: "acme"},
+			{Pattern: filepath.FromSlash("/srv/client-*"), Project: "clients"},
+		},
+	}
+
+	assert.Equal(t, "acme", cfg.ProjectForPath(filepath.Join(home, "code", "acme", "api")))
+	assert.Equal(t, "clients", cfg.ProjectForPath(filepath.FromSlash("/srv/client-a/src")))
+	assert.Equal(t, "", cfg.ProjectForPath(filepath.FromSlash("/srv/internal")))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"go.yaml.in/yaml/v3"
@@ -11,15 +12,30 @@ import (
 
 // GlobalProject represents a global project configuration
 type GlobalProject struct {
-	Name        string   `yaml:"name"`
-	HourlyRate  *float64 `yaml:"hourly_rate,omitempty"`
-	Description string   `yaml:"description,omitempty"`
-	ExportPath  string   `yaml:"export_path,omitempty"`
+	Name            string   `yaml:"name"`
+	HourlyRate      *float64 `yaml:"hourly_rate,omitempty"`
+	Currency        string   `yaml:"currency,omitempty"`
+	TaxRate         *float64 `yaml:"tax_rate,omitempty"`
+	Discount        *float64 `yaml:"discount,omitempty"`
+	Billable        *bool    `yaml:"billable,omitempty"`
+	Description     string   `yaml:"description,omitempty"`
+	ExportPath      string   `yaml:"export_path,omitempty"`
+	DailyGoalHours  *float64 `yaml:"daily_goal_hours,omitempty"`
+	WeeklyGoalHours *float64 `yaml:"weekly_goal_hours,omitempty"`
+	Paths           []string `yaml:"paths,omitempty"`
 }
 
 // ProjectsRegistry holds all global projects
 type ProjectsRegistry struct {
 	Projects []GlobalProject `yaml:"projects"`
+	Remotes  []RemoteMapping `yaml:"remotes,omitempty"`
+}
+
+// RemoteMapping assigns every clone of the repository at Remote (any form of
+// its git URL) to a project.
+type RemoteMapping struct {
+	Remote  string `yaml:"remote"`
+	Project string `yaml:"project"`
 }
 
 // GetProjectsPath returns the path to the global projects registry file
@@ -164,6 +180,99 @@ func (pr *ProjectsRegistry) DeleteProject(name string) error {
 	return fmt.Errorf("project '%s' not found", name)
 }
 
+// MergeProject folds the registry configuration of source into target. When
+// target isn't registered, source is simply renamed; otherwise target keeps its
+// settings, takes over the ones it leaves unset from source, gains source's
+// paths, and source is removed. Remote mappings are repointed either way. It
+// reports whether the registry changed.
+func (pr *ProjectsRegistry) MergeProject(source, target string) bool {
+	changed := false
+
+	for i := range pr.Remotes {
+		if strings.EqualFold(pr.Remotes[i].Project, source) {
+			pr.Remotes[i].Project = target
+			changed = true
+		}
+	}
+
+	sourceProject, err := pr.GetProject(source)
+	if err != nil {
+		return changed
+	}
+
+	targetProject, err := pr.GetProject(target)
+	if err != nil || targetProject == sourceProject {
+		sourceProject.Name = strings.TrimSpace(target)
+		return true
+	}
+
+	mergeSettings(targetProject, *sourceProject)
+
+	for _, sourcePath := range sourceProject.Paths {
+		if !slices.Contains(targetProject.Paths, sourcePath) {
+			targetProject.Paths = append(targetProject.Paths, sourcePath)
+		}
+	}
+
+	_ = pr.DeleteProject(sourceProject.Name)
+
+	return true
+}
+
+// MergeSettingsPreview lists the settings MergeProject would copy from source
+// to a registered target and the ones it would drop because target sets them
+// differently. Both are empty unless both projects are registered.
+func (pr *ProjectsRegistry) MergeSettingsPreview(source, target string) (copied, dropped []string) {
+	sourceProject, err := pr.GetProject(source)
+	if err != nil {
+		return nil, nil
+	}
+
+	targetProject, err := pr.GetProject(target)
+	if err != nil || targetProject == sourceProject {
+		return nil, nil
+	}
+
+	preview := *targetProject
+	return mergeSettings(&preview, *sourceProject)
+}
+
+// mergeSettings copies the settings target leaves unset from source, and
+// returns the names of the copied settings and of those source set
+// differently, which target's value overrides.
+func mergeSettings(target *GlobalProject, source GlobalProject) (copied, dropped []string) {
+	merge := func(name string, targetSet, sourceSet, same bool, copySetting func()) {
+		switch {
+		case !sourceSet || same:
+		case !targetSet:
+			copySetting()
+			copied = append(copied, name)
+		default:
+			dropped = append(dropped, name)
+		}
+	}
+
+	merge("hourly rate", target.HourlyRate != nil, source.HourlyRate != nil, sameFloat(target.HourlyRate, source.HourlyRate), func() { target.HourlyRate = source.HourlyRate })
+	merge("currency", target.Currency != "", source.Currency != "", strings.EqualFold(target.Currency, source.Currency), func() { target.Currency = source.Currency })
+	merge("tax rate", target.TaxRate != nil, source.TaxRate != nil, sameFloat(target.TaxRate, source.TaxRate), func() { target.TaxRate = source.TaxRate })
+	merge("discount", target.Discount != nil, source.Discount != nil, sameFloat(target.Discount, source.Discount), func() { target.Discount = source.Discount })
+	merge("billable", target.Billable != nil, source.Billable != nil, target.Billable != nil && source.Billable != nil && *target.Billable == *source.Billable, func() { target.Billable = source.Billable })
+	merge("description", target.Description != "", source.Description != "", target.Description == source.Description, func() { target.Description = source.Description })
+	merge("export path", target.ExportPath != "", source.ExportPath != "", target.ExportPath == source.ExportPath, func() { target.ExportPath = source.ExportPath })
+	merge("daily goal", target.DailyGoalHours != nil, source.DailyGoalHours != nil, sameFloat(target.DailyGoalHours, source.DailyGoalHours), func() { target.DailyGoalHours = source.DailyGoalHours })
+	merge("weekly goal", target.WeeklyGoalHours != nil, source.WeeklyGoalHours != nil, sameFloat(target.WeeklyGoalHours, source.WeeklyGoalHours), func() { target.WeeklyGoalHours = source.WeeklyGoalHours })
+
+	return copied, dropped
+}
+
+func sameFloat(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
 // ListProjects returns all projects in the registry
 func (pr *ProjectsRegistry) ListProjects() []GlobalProject {
 	return pr.Projects
@@ -174,3 +283,51 @@ func (pr *ProjectsRegistry) Exists(name string) bool {
 	_, err := pr.GetProject(name)
 	return err == nil
 }
+
+// ParseProjectPaths splits a comma-separated list of project paths, resolving
+// relative paths against the current directory and keeping "~/" paths as-is.
+func ParseProjectPaths(input string) []string {
+	var paths []string
+	for _, projectPath := range strings.Split(input, ",") {
+		projectPath = strings.TrimSpace(projectPath)
+		if projectPath == "" {
+			continue
+		}
+
+		if !filepath.IsAbs(projectPath) && projectPath != "~" && !strings.HasPrefix(projectPath, "~/") {
+			if absPath, err := filepath.Abs(projectPath); err == nil {
+				projectPath = absPath
+			}
+		}
+
+		paths = append(paths, projectPath)
+	}
+
+	return paths
+}
+
+// ProjectForPath returns the global project with a path containing dir, or nil
+// if there is none. Paths may use "~/" and the glob syntax of PathRule; when
+// several projects match, the most specific path wins.
+func (pr *ProjectsRegistry) ProjectForPath(dir string) *GlobalProject {
+	var best *GlobalProject
+	bestDepth := -1
+
+	target := filepath.ToSlash(filepath.Clean(dir))
+	for i := range pr.Projects {
+		for _, projectPath := range pr.Projects[i].Paths {
+			pattern := expandHome(strings.TrimSpace(projectPath))
+			if !filepath.IsAbs(pattern) {
+				continue
+			}
+
+			pattern = filepath.ToSlash(filepath.Clean(pattern))
+			if depth := len(splitPath(pattern)); depth > bestDepth && matchPathPattern(pattern, target) {
+				best = &pr.Projects[i]
+				bestDepth = depth
+			}
+		}
+	}
+
+	return best
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLoadProjects(t *testing.T) {
@@ -378,6 +379,96 @@ func TestDeleteProject(t *testing.T) {
 	})
 }
 
+func TestMergeProject(t *testing.T) {
+	rate := 100.0
+
+	t.Run("renames source when target is not registered", func(t *testing.T) {
+		registry := &ProjectsRegistry{
+			Projects: []GlobalProject{{Name: "api", HourlyRate: &rate}},
+			Remotes:  []RemoteMapping{{Remote: "github.com/acme/api", Project: "api"}},
+		}
+
+		assert.True(t, registry.MergeProject("api", "api-server"))
+		assert.False(t, registry.Exists("api"))
+
+		project, err := registry.GetProject("api-server")
+		require.NoError(t, err)
+		assert.Equal(t, &rate, project.HourlyRate)
+		assert.Equal(t, "api-server", registry.Remotes[0].Project)
+	})
+
+	t.Run("folds source into an existing target", func(t *testing.T) {
+		registry := &ProjectsRegistry{
+			Projects: []GlobalProject{
+				{Name: "api", HourlyRate: &rate, Paths: []string{"/work/api", "/work/shared"}},
+				{Name: "api-server", Paths: []string{"/work/shared"}},
+			},
+		}
+
+		assert.True(t, registry.MergeProject("api", "api-server"))
+		assert.Len(t, registry.Projects, 1)
+		assert.Equal(t, "api-server", registry.Projects[0].Name)
+		assert.Equal(t, &rate, registry.Projects[0].HourlyRate)
+		assert.Equal(t, []string{"/work/shared", "/work/api"}, registry.Projects[0].Paths)
+	})
+
+	t.Run("copies the settings an existing target leaves unset", func(t *testing.T) {
+		taxRate := 19.0
+		discount := 5.0
+		targetRate := 120.0
+		nonBillable := false
+		daily := 6.0
+		registry := &ProjectsRegistry{
+			Projects: []GlobalProject{
+				{Name: "api", HourlyRate: &rate, Currency: "EUR", TaxRate: &taxRate, Discount: &discount, Billable: &nonBillable, ExportPath: "~/exports", DailyGoalHours: &daily},
+				{Name: "api-server", HourlyRate: &targetRate, Currency: "eur"},
+			},
+		}
+
+		copied, dropped := registry.MergeSettingsPreview("api", "api-server")
+		assert.Equal(t, []string{"tax rate", "discount", "billable", "export path", "daily goal"}, copied)
+		assert.Equal(t, []string{"hourly rate"}, dropped)
+		assert.Nil(t, registry.Projects[1].TaxRate, "the preview leaves the registry alone")
+
+		assert.True(t, registry.MergeProject("api", "api-server"))
+		require.Len(t, registry.Projects, 1)
+
+		merged := registry.Projects[0]
+		assert.Equal(t, &targetRate, merged.HourlyRate)
+		assert.Equal(t, "eur", merged.Currency)
+		assert.Equal(t, &taxRate, merged.TaxRate)
+		assert.Equal(t, &discount, merged.Discount)
+		assert.Equal(t, &nonBillable, merged.Billable)
+		assert.Equal(t, "~/exports", merged.ExportPath)
+		assert.Equal(t, &daily, merged.DailyGoalHours)
+		assert.Nil(t, merged.WeeklyGoalHours)
+	})
+
+	t.Run("previews nothing when target is not registered", func(t *testing.T) {
+		registry := &ProjectsRegistry{Projects: []GlobalProject{{Name: "api", HourlyRate: &rate}}}
+
+		copied, dropped := registry.MergeSettingsPreview("api", "api-server")
+		assert.Empty(t, copied)
+		assert.Empty(t, dropped)
+	})
+
+	t.Run("reports no change for unregistered projects", func(t *testing.T) {
+		registry := &ProjectsRegistry{Projects: []GlobalProject{{Name: "other"}}}
+
+		assert.False(t, registry.MergeProject("api", "api-server"))
+	})
+}
+
+func TestParseProjectPaths(t *testing.T) {
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+
+	paths := ParseProjectPaths(" ~/code/acme , , /srv/acme,docs")
+	assert.Equal(t, []string{"~/code/acme", "/srv/acme", filepath.Join(cwd, "docs")}, paths)
+
+	assert.Empty(t, ParseProjectPaths(""))
+}
+
 func TestListProjects(t *testing.T) {
 	rate1 := 100.0
 	rate2 := 150.0
@@ -427,6 +518,40 @@ func TestExists(t *testing.T) {
 	})
 }
 
+func TestProjectForPath(t *testing.T) {
+	registry := &ProjectsRegistry{
+		Projects: []GlobalProject{
+			{Name: "Acme", Paths: []string{filepath.FromSlash("/work/acme")}},
+			{Name: "Acme API", Paths: []string{filepath.FromSlash("/work/acme/api")}},
+			{Name: "Clients", Paths: []string{filepath.FromSlash("/work/clients/*/site"), "relative/ignored"}},
+			{Name: "Unmapped"},
+		},
+	}
+
+	t.Run("matches directories under a project path", func(t *testing.T) {
+		found := registry.ProjectForPath(filepath.FromSlash("/work/acme/web/src"))
+		require.NotNil(t, found)
+		assert.Equal(t, "Acme", found.Name)
+	})
+
+	t.Run("prefers the most specific path", func(t *testing.T) {
+		found := registry.ProjectForPath(filepath.FromSlash("/work/acme/api/handlers"))
+		require.NotNil(t, found)
+		assert.Equal(t, "Acme API", found.Name)
+	})
+
+	t.Run("supports globs", func(t *testing.T) {
+		found := registry.ProjectForPath(filepath.FromSlash("/work/clients/bobs-bakery/site"))
+		require.NotNil(t, found)
+		assert.Equal(t, "Clients", found.Name)
+	})
+
+	t.Run("returns nil outside all paths", func(t *testing.T) {
+		assert.Nil(t, registry.ProjectForPath(filepath.FromSlash("/work/acmeco")))
+		assert.Nil(t, registry.ProjectForPath(filepath.FromSlash("/work/relative/ignored")))
+	})
+}
+
 func TestGetProjectsPath(t *testing.T) {
 	tmpDir := t.TempDir()
 
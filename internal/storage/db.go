@@ -2,6 +2,7 @@ package storage
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -15,11 +16,14 @@ type Database struct {
 	db *sql.DB
 }
 
-func Initialize() (*Database, error) {
+// ErrNoDatabase is returned by OpenReadOnly when no database has been created yet.
+var ErrNoDatabase = errors.New("no tmpo database found")
+
+func getDatabasePath() (string, error) {
 	homeDir, err := os.UserHomeDir()
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get home directory: %w", err)
+		return "", fmt.Errorf("failed to get home directory: %w", err)
 	}
 
 	tmpoDir := filepath.Join(homeDir, ".tmpo")
@@ -27,11 +31,40 @@ func Initialize() (*Database, error) {
 		tmpoDir = filepath.Join(homeDir, ".tmpo-dev")
 	}
 
-	if err := os.MkdirAll(tmpoDir, 0755); err != nil {
+	return filepath.Join(tmpoDir, "tmpo.db"), nil
+}
+
+// OpenReadOnly opens the existing database without creating tables or running
+// migrations. It is meant for hot paths like shell prompts that only read and
+// must stay fast; everything else should use Initialize.
+func OpenReadOnly() (*Database, error) {
+	dbPath, err := getDatabasePath()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		return nil, ErrNoDatabase
+	}
+
+	db, err := sql.Open("sqlite", "file:"+filepath.ToSlash(dbPath)+"?mode=ro")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	return &Database{db: db}, nil
+}
+
+func Initialize() (*Database, error) {
+	dbPath, err := getDatabasePath()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dbPath), 0755); err != nil {
 		return nil, fmt.Errorf("failed to create .tmpo directory: %w", err)
 	}
 
-	dbPath := filepath.Join(tmpoDir, "tmpo.db")
 	db, err := sql.Open("sqlite", dbPath)
 
 	if err != nil {
@@ -83,11 +116,143 @@ func Initialize() (*Database, error) {
 		return nil, fmt.Errorf("failed to create index: %w", err)
 	}
 
+	_, err = db.Exec(`ALTER TABLE time_entries ADD COLUMN milestone_id INTEGER REFERENCES milestones(id)`)
+	if err != nil && !isColumnExistsError(err) {
+		return nil, fmt.Errorf("failed to add milestone_id column: %w", err)
+	}
+
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_time_entries_milestone_id ON time_entries(milestone_id)`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create index: %w", err)
+	}
+
+	_, err = db.Exec(`ALTER TABLE milestones ADD COLUMN activated_at DATETIME`)
+	if err != nil && !isColumnExistsError(err) {
+		return nil, fmt.Errorf("failed to add activated_at column: %w", err)
+	}
+
+	_, err = db.Exec(`ALTER TABLE time_entries ADD COLUMN tags TEXT`)
+	if err != nil && !isColumnExistsError(err) {
+		return nil, fmt.Errorf("failed to add tags column: %w", err)
+	}
+
+	_, err = db.Exec(`ALTER TABLE time_entries ADD COLUMN issue_key TEXT`)
+	if err != nil && !isColumnExistsError(err) {
+		return nil, fmt.Errorf("failed to add issue_key column: %w", err)
+	}
+
+	_, err = db.Exec(`ALTER TABLE time_entries ADD COLUMN notes TEXT`)
+	if err != nil && !isColumnExistsError(err) {
+		return nil, fmt.Errorf("failed to add notes column: %w", err)
+	}
+
+	// entries tracked before the flag existed all counted as billable
+	_, err = db.Exec(`ALTER TABLE time_entries ADD COLUMN billable INTEGER NOT NULL DEFAULT 1`)
+	if err != nil && !isColumnExistsError(err) {
+		return nil, fmt.Errorf("failed to add billable column: %w", err)
+	}
+
 	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_milestones_project_active ON milestones(project_name, end_time)`)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create index: %w", err)
 	}
 
+	// partial index so looking up the running entry stays cheap for prompt integrations
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_time_entries_running ON time_entries(start_time) WHERE end_time IS NULL`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create index: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS entry_commits (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			entry_id INTEGER NOT NULL REFERENCES time_entries(id),
+			sha TEXT NOT NULL,
+			message TEXT,
+			committed_at DATETIME NOT NULL,
+			UNIQUE(entry_id, sha)
+		)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create entry_commits table: %w", err)
+	}
+
+	_, err = db.Exec(`CREATE INDEX IF NOT EXISTS idx_entry_commits_entry_id ON entry_commits(entry_id)`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create index: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS archived_projects (
+			project_name TEXT PRIMARY KEY,
+			archived_at DATETIME NOT NULL
+		)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create archived_projects table: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS rates (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			project_name TEXT NOT NULL,
+			milestone_id INTEGER REFERENCES milestones(id),
+			tag TEXT,
+			hourly_rate REAL NOT NULL,
+			effective_from DATETIME NOT NULL
+		)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rates table: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS fx_rates (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			base TEXT NOT NULL,
+			quote TEXT NOT NULL,
+			rate REAL NOT NULL,
+			date DATETIME NOT NULL,
+			UNIQUE(base, quote, date)
+		)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create fx_rates table: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS expenses (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			project_name TEXT NOT NULL,
+			date DATETIME NOT NULL,
+			amount REAL NOT NULL,
+			currency TEXT NOT NULL,
+			category TEXT,
+			description TEXT,
+			receipt_path TEXT
+		)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create expenses table: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS retainers (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			project_name TEXT NOT NULL,
+			date DATETIME NOT NULL,
+			hours REAL NOT NULL,
+			note TEXT
+		)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create retainers table: %w", err)
+	}
+
+	if err := createSearchIndex(db); err != nil {
+		return nil, err
+	}
+
 	// settings table for tracking migrations and other metadata
 	_, err = db.Exec(`
 		CREATE TABLE IF NOT EXISTS settings (
@@ -118,24 +283,101 @@ func isColumnExistsError(err error) bool {
 		strings.Contains(errMsg, "duplicate column")
 }
 
-func (d *Database) CreateEntry(projectName, description string, hourlyRate *float64, milestoneName *string) (*TimeEntry, error) {
+// entryColumns is the column list used by every time entry query. The
+// milestone name is resolved through milestone_id so renames are reflected
+// on every entry without rewriting rows.
+const entryColumns = `e.id, e.project_name, e.start_time, e.end_time, e.description, e.hourly_rate, e.milestone_id, m.name, e.tags, e.issue_key, e.notes, e.billable`
+
+const entryFrom = `FROM time_entries e LEFT JOIN milestones m ON m.id = e.milestone_id`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanEntry scans the entryColumns of a row, followed by any extra columns.
+func scanEntry(row rowScanner, extra ...any) (*TimeEntry, error) {
+	var entry TimeEntry
+	var endTime sql.NullTime
+	var hourlyRate sql.NullFloat64
+	var milestoneID sql.NullInt64
+	var milestoneName sql.NullString
+	var tags sql.NullString
+	var issueKey sql.NullString
+	var notes sql.NullString
+
+	dest := []any{&entry.ID, &entry.ProjectName, &entry.StartTime, &endTime, &entry.Description, &hourlyRate, &milestoneID, &milestoneName, &tags, &issueKey, &notes, &entry.Billable}
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if endTime.Valid {
+		entry.EndTime = &endTime.Time
+	}
+
+	if hourlyRate.Valid {
+		entry.HourlyRate = &hourlyRate.Float64
+	}
+
+	if milestoneID.Valid {
+		entry.MilestoneID = &milestoneID.Int64
+	}
+
+	if milestoneName.Valid {
+		entry.MilestoneName = &milestoneName.String
+	}
+
+	if tags.Valid {
+		entry.Tags = splitTags(tags.String)
+	}
+
+	entry.IssueKey = issueKey.String
+	entry.Notes = notes.String
+
+	return &entry, nil
+}
+
+func (d *Database) queryEntries(query string, args ...any) ([]*TimeEntry, error) {
+	rows, err := d.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query entries: %w", err)
+	}
+
+	defer rows.Close()
+
+	var entries []*TimeEntry
+
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan entry: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (d *Database) CreateEntry(projectName, description string, hourlyRate *float64, milestoneID *int64, billable bool) (*TimeEntry, error) {
 	var rate sql.NullFloat64
 	if hourlyRate != nil {
 		rate = sql.NullFloat64{Float64: *hourlyRate, Valid: true}
 	}
 
-	var milestone sql.NullString
-	if milestoneName != nil {
-		milestone = sql.NullString{String: *milestoneName, Valid: true}
+	var milestone sql.NullInt64
+	if milestoneID != nil {
+		milestone = sql.NullInt64{Int64: *milestoneID, Valid: true}
 	}
 
 	result, err := d.db.Exec(
-		"INSERT INTO time_entries (project_name, start_time, description, hourly_rate, milestone_name) VALUES (?, ?, ?, ?, ?)",
+		"INSERT INTO time_entries (project_name, start_time, description, hourly_rate, milestone_id, billable) VALUES (?, ?, ?, ?, ?, ?)",
 		projectName,
 		time.Now().UTC(),
 		description,
 		rate,
 		milestone,
+		billable,
 	)
 
 	if err != nil {
@@ -151,28 +393,29 @@ func (d *Database) CreateEntry(projectName, description string, hourlyRate *floa
 	return d.GetEntry(id)
 }
 
-func (d *Database) CreateManualEntry(projectName, description string, startTime, endTime time.Time, hourlyRate *float64, milestoneName *string) (*TimeEntry, error) {
+func (d *Database) CreateManualEntry(projectName, description string, startTime, endTime time.Time, hourlyRate *float64, milestoneID *int64, billable bool) (*TimeEntry, error) {
 	var rate sql.NullFloat64
 	if hourlyRate != nil {
 		rate = sql.NullFloat64{Float64: *hourlyRate, Valid: true}
 	}
 
-	var milestone sql.NullString
-	if milestoneName != nil {
-		milestone = sql.NullString{String: *milestoneName, Valid: true}
+	var milestone sql.NullInt64
+	if milestoneID != nil {
+		milestone = sql.NullInt64{Int64: *milestoneID, Valid: true}
 	}
 
 	startTimeUTC := startTime.UTC()
 	endTimeUTC := endTime.UTC()
 
 	result, err := d.db.Exec(
-		"INSERT INTO time_entries (project_name, start_time, end_time, description, hourly_rate, milestone_name) VALUES (?, ?, ?, ?, ?, ?)",
+		"INSERT INTO time_entries (project_name, start_time, end_time, description, hourly_rate, milestone_id, billable) VALUES (?, ?, ?, ?, ?, ?, ?)",
 		projectName,
 		startTimeUTC,
 		endTimeUTC,
 		description,
 		rate,
 		milestone,
+		billable,
 	)
 
 	if err != nil {
@@ -189,18 +432,13 @@ func (d *Database) CreateManualEntry(projectName, description string, startTime,
 }
 
 func (d *Database) GetRunningEntry() (*TimeEntry, error) {
-	var entry TimeEntry
-	var endTime sql.NullTime
-	var hourlyRate sql.NullFloat64
-	var milestoneName sql.NullString
-
-	err := d.db.QueryRow(`
-		SELECT id, project_name, start_time, end_time, description, hourly_rate, milestone_name
-		FROM time_entries
-		WHERE end_time IS NULL
-		ORDER BY start_time DESC
+	entry, err := scanEntry(d.db.QueryRow(`
+		SELECT ` + entryColumns + `
+		` + entryFrom + `
+		WHERE e.end_time IS NULL
+		ORDER BY e.start_time DESC
 		LIMIT 1
-	`).Scan(&entry.ID, &entry.ProjectName, &entry.StartTime, &endTime, &entry.Description, &hourlyRate, &milestoneName)
+	`))
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -210,34 +448,17 @@ func (d *Database) GetRunningEntry() (*TimeEntry, error) {
 		return nil, fmt.Errorf("failed to get running entry: %w", err)
 	}
 
-	if endTime.Valid {
-		entry.EndTime = &endTime.Time
-	}
-
-	if hourlyRate.Valid {
-		entry.HourlyRate = &hourlyRate.Float64
-	}
-
-	if milestoneName.Valid {
-		entry.MilestoneName = &milestoneName.String
-	}
-
-	return &entry, nil
+	return entry, nil
 }
 
 func (d *Database) GetLastStoppedEntry() (*TimeEntry, error) {
-	var entry TimeEntry
-	var endTime sql.NullTime
-	var hourlyRate sql.NullFloat64
-	var milestoneName sql.NullString
-
-	err := d.db.QueryRow(`
-		SELECT id, project_name, start_time, end_time, description, hourly_rate, milestone_name
-		FROM time_entries
-		WHERE end_time IS NOT NULL
-		ORDER BY start_time DESC
+	entry, err := scanEntry(d.db.QueryRow(`
+		SELECT ` + entryColumns + `
+		` + entryFrom + `
+		WHERE e.end_time IS NOT NULL
+		ORDER BY e.start_time DESC
 		LIMIT 1
-	`).Scan(&entry.ID, &entry.ProjectName, &entry.StartTime, &endTime, &entry.Description, &hourlyRate, &milestoneName)
+	`))
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -247,34 +468,17 @@ func (d *Database) GetLastStoppedEntry() (*TimeEntry, error) {
 		return nil, fmt.Errorf("failed to get last stopped entry: %w", err)
 	}
 
-	if endTime.Valid {
-		entry.EndTime = &endTime.Time
-	}
-
-	if hourlyRate.Valid {
-		entry.HourlyRate = &hourlyRate.Float64
-	}
-
-	if milestoneName.Valid {
-		entry.MilestoneName = &milestoneName.String
-	}
-
-	return &entry, nil
+	return entry, nil
 }
 
 func (d *Database) GetLastStoppedEntryByProject(projectName string) (*TimeEntry, error) {
-	var entry TimeEntry
-	var endTime sql.NullTime
-	var hourlyRate sql.NullFloat64
-	var milestoneName sql.NullString
-
-	err := d.db.QueryRow(`
-		SELECT id, project_name, start_time, end_time, description, hourly_rate, milestone_name
-		FROM time_entries
-		WHERE end_time IS NOT NULL AND project_name = ?
-		ORDER BY start_time DESC
+	entry, err := scanEntry(d.db.QueryRow(`
+		SELECT `+entryColumns+`
+		`+entryFrom+`
+		WHERE e.end_time IS NOT NULL AND e.project_name = ?
+		ORDER BY e.start_time DESC
 		LIMIT 1
-	`, projectName).Scan(&entry.ID, &entry.ProjectName, &entry.StartTime, &endTime, &entry.Description, &hourlyRate, &milestoneName)
+	`, projectName))
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -284,19 +488,7 @@ func (d *Database) GetLastStoppedEntryByProject(projectName string) (*TimeEntry,
 		return nil, fmt.Errorf("failed to get last stopped entry for project: %w", err)
 	}
 
-	if endTime.Valid {
-		entry.EndTime = &endTime.Time
-	}
-
-	if hourlyRate.Valid {
-		entry.HourlyRate = &hourlyRate.Float64
-	}
-
-	if milestoneName.Valid {
-		entry.MilestoneName = &milestoneName.String
-	}
-
-	return &entry, nil
+	return entry, nil
 }
 
 func (d *Database) StopEntry(id int64) error {
@@ -306,7 +498,7 @@ func (d *Database) StopEntry(id int64) error {
 		id,
 	)
 
-	if(err != nil) {
+	if err != nil {
 		return fmt.Errorf("failed to stop entry: %w", err)
 	}
 
@@ -314,324 +506,752 @@ func (d *Database) StopEntry(id int64) error {
 }
 
 func (d *Database) GetEntry(id int64) (*TimeEntry, error) {
-	var entry TimeEntry
-	var endTime sql.NullTime
-	var hourlyRate sql.NullFloat64
-	var milestoneName sql.NullString
-
-	err := d.db.QueryRow(`
-		SELECT id, project_name, start_time, end_time, description, hourly_rate, milestone_name
-		FROM time_entries
-		WHERE id = ?
-	`, id).Scan(&entry.ID, &entry.ProjectName, &entry.StartTime, &endTime, &entry.Description, &hourlyRate, &milestoneName)
+	entry, err := scanEntry(d.db.QueryRow(`
+		SELECT `+entryColumns+`
+		`+entryFrom+`
+		WHERE e.id = ?
+	`, id))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get entry: %w", err)
 	}
 
-	if endTime.Valid {
-		entry.EndTime = &endTime.Time
-	}
-
-	if hourlyRate.Valid {
-		entry.HourlyRate = &hourlyRate.Float64
-	}
-
-	if milestoneName.Valid {
-		entry.MilestoneName = &milestoneName.String
-	}
-
-	return &entry, nil
+	return entry, nil
 }
 
 func (d *Database) GetEntries(limit int) ([]*TimeEntry, error) {
 	query := `
-		SELECT id, project_name, start_time, end_time, description, hourly_rate, milestone_name
-		FROM time_entries
-		ORDER BY start_time DESC
+		SELECT ` + entryColumns + `
+		` + entryFrom + `
+		ORDER BY e.start_time DESC
 	`
 
 	if limit > 0 {
 		query += fmt.Sprintf(" LIMIT %d", limit)
 	}
 
-	rows, err := d.db.Query(query)
+	return d.queryEntries(query)
+}
+
+func (d *Database) GetEntriesByProject(projectName string) ([]*TimeEntry, error) {
+	return d.queryEntries(`
+		SELECT `+entryColumns+`
+		`+entryFrom+`
+		WHERE e.project_name = ?
+		ORDER BY e.start_time DESC
+	`, projectName)
+}
+
+func (d *Database) GetEntriesByDateRange(start, end time.Time) ([]*TimeEntry, error) {
+	// Convert to UTC for consistent comparison with stored timestamps
+	startUTC := start.UTC()
+	endUTC := end.UTC()
+
+	return d.queryEntries(`
+		SELECT `+entryColumns+`
+		`+entryFrom+`
+		WHERE e.start_time BETWEEN ? AND ?
+		ORDER BY e.start_time DESC
+	`, startUTC, endUTC)
+}
+
+// GetAllProjects returns the names of projects with entries, excluding
+// archived projects.
+func (d *Database) GetAllProjects() ([]string, error) {
+	rows, err := d.db.Query(`
+		SELECT DISTINCT project_name
+		FROM time_entries
+		WHERE project_name NOT IN (SELECT project_name FROM archived_projects)
+		ORDER BY project_name
+	`)
+
 	if err != nil {
-		return nil, fmt.Errorf("failed to query entries: %w", err)
+		return nil, fmt.Errorf("failed to query projects: %w", err)
 	}
 
 	defer rows.Close()
 
-	var entries []*TimeEntry
+	var projects []string
 
 	for rows.Next() {
-		var entry TimeEntry
-		var endTime sql.NullTime
-		var hourlyRate sql.NullFloat64
-		var milestoneName sql.NullString
-
-		err := rows.Scan(&entry.ID, &entry.ProjectName, &entry.StartTime, &endTime, &entry.Description, &hourlyRate, &milestoneName)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan entry: %w", err)
+		var project string
+		if err := rows.Scan(&project); err != nil {
+			return nil, fmt.Errorf("failed to scan project: %w", err)
 		}
 
-		if endTime.Valid {
-			entry.EndTime = &endTime.Time
-		}
+		projects = append(projects, project)
+	}
 
-		if hourlyRate.Valid {
-			entry.HourlyRate = &hourlyRate.Float64
-		}
+	return projects, nil
+}
 
-		if milestoneName.Valid {
-			entry.MilestoneName = &milestoneName.String
+// GetProjectsWithCompletedEntries returns the names of projects with at least
+// one stopped entry, excluding archived projects.
+func (d *Database) GetProjectsWithCompletedEntries() ([]string, error) {
+	rows, err := d.db.Query(`
+		SELECT DISTINCT project_name
+		FROM time_entries
+		WHERE end_time IS NOT NULL
+			AND project_name NOT IN (SELECT project_name FROM archived_projects)
+		ORDER BY project_name
+	`)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query projects: %w", err)
+	}
+
+	defer rows.Close()
+
+	var projects []string
+
+	for rows.Next() {
+		var project string
+		if err := rows.Scan(&project); err != nil {
+			return nil, fmt.Errorf("failed to scan project: %w", err)
 		}
 
-		entries = append(entries, &entry)
+		projects = append(projects, project)
 	}
 
-	return entries, nil
+	return projects, nil
 }
 
-func (d *Database) GetEntriesByProject(projectName string) ([]*TimeEntry, error) {
-	rows, err := d.db.Query(`
-		SELECT id, project_name, start_time, end_time, description, hourly_rate, milestone_name
-		FROM time_entries
-		WHERE project_name = ?
-		ORDER BY start_time DESC
+func (d *Database) GetCompletedEntriesByProject(projectName string) ([]*TimeEntry, error) {
+	return d.queryEntries(`
+		SELECT `+entryColumns+`
+		`+entryFrom+`
+		WHERE e.project_name = ? AND e.end_time IS NOT NULL
+		ORDER BY e.start_time DESC
 	`, projectName)
+}
+
+func (d *Database) UpdateTimeEntry(id int64, entry *TimeEntry) error {
+	startTimeUTC := entry.StartTime.UTC()
+
+	var endTime sql.NullTime
+	if entry.EndTime != nil {
+		endTime = sql.NullTime{Time: entry.EndTime.UTC(), Valid: true}
+	}
+
+	var hourlyRate sql.NullFloat64
+	if entry.HourlyRate != nil {
+		hourlyRate = sql.NullFloat64{Float64: *entry.HourlyRate, Valid: true}
+	}
+
+	var milestoneID sql.NullInt64
+	if entry.MilestoneID != nil {
+		milestoneID = sql.NullInt64{Int64: *entry.MilestoneID, Valid: true}
+	}
+
+	_, err := d.db.Exec(`
+		UPDATE time_entries
+		SET project_name = ?, start_time = ?, end_time = ?, description = ?, hourly_rate = ?, milestone_id = ?, tags = ?, issue_key = ?, notes = ?, billable = ?
+		WHERE id = ?
+	`, entry.ProjectName, startTimeUTC, endTime, entry.Description, hourlyRate, milestoneID, joinTags(entry.Tags), nullString(entry.IssueKey), nullString(entry.Notes), entry.Billable, id)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to query entries: %w", err)
+		return fmt.Errorf("failed to update entry: %w", err)
 	}
 
-	defer rows.Close()
+	return nil
+}
 
-	var entries []*TimeEntry
+// SetEntryEndTime sets when an entry ended, leaving its other fields alone.
+func (d *Database) SetEntryEndTime(id int64, endTime time.Time) error {
+	_, err := d.db.Exec("UPDATE time_entries SET end_time = ? WHERE id = ?", endTime.UTC(), id)
+	if err != nil {
+		return fmt.Errorf("failed to set entry end time: %w", err)
+	}
 
-	for rows.Next() {
-		var entry TimeEntry
-		var endTime sql.NullTime
-		var hourlyRate sql.NullFloat64
-		var milestoneName sql.NullString
+	return nil
+}
 
-		err := rows.Scan(&entry.ID, &entry.ProjectName, &entry.StartTime, &endTime, &entry.Description, &hourlyRate, &milestoneName)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan entry: %w", err)
-		}
+// SetEntryTags replaces the tags on an entry.
+func (d *Database) SetEntryTags(id int64, tags []string) error {
+	_, err := d.db.Exec("UPDATE time_entries SET tags = ? WHERE id = ?", joinTags(tags), id)
+	if err != nil {
+		return fmt.Errorf("failed to set entry tags: %w", err)
+	}
 
-		if endTime.Valid {
-			entry.EndTime = &endTime.Time
-		}
+	return nil
+}
 
-		if hourlyRate.Valid {
-			entry.HourlyRate = &hourlyRate.Float64
-		}
+// SetEntryNotes replaces the notes on an entry.
+func (d *Database) SetEntryNotes(id int64, notes string) error {
+	_, err := d.db.Exec("UPDATE time_entries SET notes = ? WHERE id = ?", nullString(notes), id)
+	if err != nil {
+		return fmt.Errorf("failed to set entry notes: %w", err)
+	}
 
-		if milestoneName.Valid {
-			entry.MilestoneName = &milestoneName.String
-		}
+	return nil
+}
 
-		entries = append(entries, &entry)
+// SetEntryBillable marks an entry as billable or non-billable. Only billable
+// time counts towards earnings.
+func (d *Database) SetEntryBillable(id int64, billable bool) error {
+	_, err := d.db.Exec("UPDATE time_entries SET billable = ? WHERE id = ?", billable, id)
+	if err != nil {
+		return fmt.Errorf("failed to set entry billable: %w", err)
 	}
 
-	return entries, nil
+	return nil
 }
 
-func (d *Database) GetEntriesByDateRange(start, end time.Time) ([]*TimeEntry, error) {
-	// Convert to UTC for consistent comparison with stored timestamps
-	startUTC := start.UTC()
-	endUTC := end.UTC()
+// SetEntryIssueKey records the issue tracker key (e.g. PROJ-123) for an entry.
+func (d *Database) SetEntryIssueKey(id int64, issueKey string) error {
+	_, err := d.db.Exec("UPDATE time_entries SET issue_key = ? WHERE id = ?", nullString(issueKey), id)
+	if err != nil {
+		return fmt.Errorf("failed to set entry issue key: %w", err)
+	}
 
-	rows, err := d.db.Query(`
-		SELECT id, project_name, start_time, end_time, description, hourly_rate, milestone_name
-		FROM time_entries
-		WHERE start_time BETWEEN ? AND ?
-		ORDER BY start_time DESC
-	`, startUTC, endUTC)
+	return nil
+}
 
+func (d *Database) DeleteTimeEntry(id int64) error {
+	_, err := d.db.Exec("DELETE FROM entry_commits WHERE entry_id = ?", id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query entries: %w", err)
+		return fmt.Errorf("failed to delete entry commits: %w", err)
 	}
 
-	defer rows.Close()
+	_, err = d.db.Exec("DELETE FROM time_entries WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete entry: %w", err)
+	}
+	return nil
+}
 
-	var entries []*TimeEntry
+// EntryFilter selects entries for bulk updates and deletes. Empty fields match
+// every entry.
+type EntryFilter struct {
+	Project     string
+	Milestone   string
+	Tag         string
+	IssueKey    string
+	Description string // matched as a case-insensitive substring
+	Start       *time.Time
+	End         *time.Time // exclusive
+}
 
-	for rows.Next() {
-		var entry TimeEntry
-		var endTime sql.NullTime
-		var hourlyRate sql.NullFloat64
+// IsEmpty reports whether the filter would match every entry.
+func (f EntryFilter) IsEmpty() bool {
+	return f.Project == "" && f.Milestone == "" && f.Tag == "" && f.IssueKey == "" &&
+		f.Description == "" && f.Start == nil && f.End == nil
+}
+
+// conditions returns the SQL conditions and arguments for the filter. Tags
+// are left out: FindEntries matches them with HasTag, and search adds
+// tagCondition so it can filter before its limit.
+func (f EntryFilter) conditions() ([]string, []any) {
+	var conditions []string
+	var args []any
+
+	if f.Project != "" {
+		conditions = append(conditions, "e.project_name = ?")
+		args = append(args, f.Project)
+	}
+	if f.Milestone != "" {
+		conditions = append(conditions, "m.name = ?")
+		args = append(args, f.Milestone)
+	}
+	if f.IssueKey != "" {
+		conditions = append(conditions, "e.issue_key = ? COLLATE NOCASE")
+		args = append(args, f.IssueKey)
+	}
+	if f.Description != "" {
+		conditions = append(conditions, `e.description LIKE ? ESCAPE '\'`)
+		args = append(args, "%"+escapeLike(f.Description)+"%")
+	}
+	if f.Start != nil {
+		conditions = append(conditions, "e.start_time >= ?")
+		args = append(args, f.Start.UTC())
+	}
+	if f.End != nil {
+		conditions = append(conditions, "e.start_time < ?")
+		args = append(args, f.End.UTC())
+	}
+
+	return conditions, args
+}
+
+// tagCondition returns the SQL condition and argument matching the filter's
+// tag in the comma separated tags column. Like LIKE, it only ignores the case
+// of ASCII letters.
+func (f EntryFilter) tagCondition() (string, any) {
+	return `(',' || e.tags || ',') LIKE ? ESCAPE '\'`, "%," + escapeLike(f.Tag) + ",%"
+}
+
+// FindEntries returns the entries matching filter, oldest first.
+func (d *Database) FindEntries(filter EntryFilter) ([]*TimeEntry, error) {
+	conditions, args := filter.conditions()
+
+	query := `
+		SELECT ` + entryColumns + `
+		` + entryFrom
+	if len(conditions) > 0 {
+		query += "\nWHERE " + strings.Join(conditions, " AND ")
+	}
+	query += "\nORDER BY e.start_time ASC"
+
+	entries, err := d.queryEntries(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Tag == "" {
+		return entries, nil
+	}
+
+	// tags live in a comma separated column, so match them here
+	var tagged []*TimeEntry
+	for _, entry := range entries {
+		if entry.HasTag(filter.Tag) {
+			tagged = append(tagged, entry)
+		}
+	}
+
+	return tagged, nil
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
+
+// EntryUpdate describes a bulk change. A nil field is left as is; an empty
+// Milestone removes the milestone assignment.
+type EntryUpdate struct {
+	Project   *string
+	Milestone *string
+	Billable  *bool
+}
+
+// UpdateEntries applies update to the entries with the given IDs in a single
+// transaction. Milestones are resolved by name in each entry's (new) project.
+// Entries moved to another project keep their milestone if the new project has
+// one with the same name, and lose it otherwise.
+func (d *Database) UpdateEntries(ids []int64, update EntryUpdate) (int64, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var updated int64
+	for _, id := range ids {
+		var projectName string
 		var milestoneName sql.NullString
 
-		err := rows.Scan(&entry.ID, &entry.ProjectName, &entry.StartTime, &endTime, &entry.Description, &hourlyRate, &milestoneName)
+		err := tx.QueryRow(`
+			SELECT e.project_name, m.name
+			`+entryFrom+`
+			WHERE e.id = ?
+		`, id).Scan(&projectName, &milestoneName)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan entry: %w", err)
+			return 0, fmt.Errorf("failed to get entry %d: %w", id, err)
 		}
 
-		if endTime.Valid {
-			entry.EndTime = &endTime.Time
+		if update.Project != nil {
+			projectName = *update.Project
 		}
 
-		if hourlyRate.Valid {
-			entry.HourlyRate = &hourlyRate.Float64
+		name := milestoneName.String
+		if update.Milestone != nil {
+			name = *update.Milestone
 		}
 
-		if milestoneName.Valid {
-			entry.MilestoneName = &milestoneName.String
+		var milestoneID sql.NullInt64
+		if name != "" {
+			err := tx.QueryRow("SELECT id FROM milestones WHERE project_name = ? AND name = ?", projectName, name).Scan(&milestoneID)
+			if err == sql.ErrNoRows && update.Milestone != nil {
+				return 0, fmt.Errorf("milestone '%s' not found for project '%s'", name, projectName)
+			}
+			if err != nil && err != sql.ErrNoRows {
+				return 0, fmt.Errorf("failed to get milestone: %w", err)
+			}
 		}
 
-		entries = append(entries, &entry)
+		var billable sql.NullBool
+		if update.Billable != nil {
+			billable = sql.NullBool{Bool: *update.Billable, Valid: true}
+		}
+
+		result, err := tx.Exec("UPDATE time_entries SET project_name = ?, milestone_id = ?, billable = COALESCE(?, billable) WHERE id = ?", projectName, milestoneID, billable, id)
+		if err != nil {
+			return 0, fmt.Errorf("failed to update entry %d: %w", id, err)
+		}
+
+		affected, _ := result.RowsAffected()
+		updated += affected
 	}
 
-	return entries, nil
-}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
 
-func (d *Database) GetAllProjects() ([]string, error) {
-	rows, err := d.db.Query(`
-		SELECT DISTINCT project_name
-		FROM time_entries
-		ORDER BY project_name
-	`)
+	return updated, nil
+}
 
+// DeleteEntries deletes the entries with the given IDs, and their linked
+// commits, in a single transaction.
+func (d *Database) DeleteEntries(ids []int64) (int64, error) {
+	tx, err := d.db.Begin()
 	if err != nil {
-		return nil, fmt.Errorf("failed to query projects: %w", err)
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	defer rows.Close()
-
-	var projects []string
+	var deleted int64
+	for _, id := range ids {
+		if _, err := tx.Exec("DELETE FROM entry_commits WHERE entry_id = ?", id); err != nil {
+			return 0, fmt.Errorf("failed to delete entry commits: %w", err)
+		}
 
-	for rows.Next() {
-		var project string
-		if err := rows.Scan(&project); err != nil {
-			return nil, fmt.Errorf("failed to scan project: %w", err)
+		result, err := tx.Exec("DELETE FROM time_entries WHERE id = ?", id)
+		if err != nil {
+			return 0, fmt.Errorf("failed to delete entry %d: %w", id, err)
 		}
 
-		projects = append(projects, project)
+		affected, _ := result.RowsAffected()
+		deleted += affected
 	}
 
-	return projects, nil
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return deleted, nil
 }
 
-func (d *Database) GetProjectsWithCompletedEntries() ([]string, error) {
-	rows, err := d.db.Query(`
-		SELECT DISTINCT project_name
-		FROM time_entries
-		WHERE end_time IS NOT NULL
-		ORDER BY project_name
-	`)
+func getEntryTx(tx *sql.Tx, id int64) (*TimeEntry, error) {
+	entry, err := scanEntry(tx.QueryRow(`
+		SELECT `+entryColumns+`
+		`+entryFrom+`
+		WHERE e.id = ?
+	`, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("entry #%d not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entry: %w", err)
+	}
+
+	return entry, nil
+}
 
+// SplitEntry splits an entry in two at the given time. The first part keeps
+// the entry's ID; the second part starts at `at`, copies the rate, milestone,
+// tags, issue key, notes and billable flag, and uses description when it
+// isn't empty. Commits made
+// after `at` move to the second part. A running entry can be split too, in
+// which case the second part keeps running.
+func (d *Database) SplitEntry(id int64, at time.Time, description string) (*TimeEntry, *TimeEntry, error) {
+	tx, err := d.db.Begin()
 	if err != nil {
-		return nil, fmt.Errorf("failed to query projects: %w", err)
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	defer rows.Close()
+	entry, err := getEntryTx(tx, id)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	var projects []string
+	end := time.Now()
+	if entry.EndTime != nil {
+		end = *entry.EndTime
+	}
 
-	for rows.Next() {
-		var project string
-		if err := rows.Scan(&project); err != nil {
-			return nil, fmt.Errorf("failed to scan project: %w", err)
-		}
+	if !at.After(entry.StartTime) || !at.Before(end) {
+		return nil, nil, fmt.Errorf("split time must be between the entry's start and end")
+	}
 
-		projects = append(projects, project)
+	if description == "" {
+		description = entry.Description
 	}
 
-	return projects, nil
+	var rate sql.NullFloat64
+	if entry.HourlyRate != nil {
+		rate = sql.NullFloat64{Float64: *entry.HourlyRate, Valid: true}
+	}
+
+	var milestoneID sql.NullInt64
+	if entry.MilestoneID != nil {
+		milestoneID = sql.NullInt64{Int64: *entry.MilestoneID, Valid: true}
+	}
+
+	var endTime sql.NullTime
+	if entry.EndTime != nil {
+		endTime = sql.NullTime{Time: entry.EndTime.UTC(), Valid: true}
+	}
+
+	result, err := tx.Exec(
+		"INSERT INTO time_entries (project_name, start_time, end_time, description, hourly_rate, milestone_id, tags, issue_key, notes, billable) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		entry.ProjectName,
+		at.UTC(),
+		endTime,
+		description,
+		rate,
+		milestoneID,
+		joinTags(entry.Tags),
+		nullString(entry.IssueKey),
+		nullString(entry.Notes),
+		entry.Billable,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create entry: %w", err)
+	}
+
+	secondID, err := result.LastInsertId()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	if _, err := tx.Exec("UPDATE time_entries SET end_time = ? WHERE id = ?", at.UTC(), id); err != nil {
+		return nil, nil, fmt.Errorf("failed to update entry: %w", err)
+	}
+
+	if _, err := tx.Exec("UPDATE entry_commits SET entry_id = ? WHERE entry_id = ? AND committed_at >= ?", secondID, id, at.UTC()); err != nil {
+		return nil, nil, fmt.Errorf("failed to move entry commits: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	first, err := d.GetEntry(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	second, err := d.GetEntry(secondID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return first, second, nil
 }
 
-func (d *Database) GetCompletedEntriesByProject(projectName string) ([]*TimeEntry, error) {
-	rows, err := d.db.Query(`
-		SELECT id, project_name, start_time, end_time, description, hourly_rate, milestone_name
-		FROM time_entries
-		WHERE project_name = ? AND end_time IS NOT NULL
-		ORDER BY start_time DESC
-	`, projectName)
+// MergeEntries combines two consecutive entries of the same project into the
+// earlier one, which then runs until the later one ended. Both entries must
+// have the same rate, milestone and billable flag. Tags are combined, differing descriptions
+// and notes are joined, and the later entry's commits move to the merged entry.
+func (d *Database) MergeEntries(firstID, secondID int64) (*TimeEntry, error) {
+	if firstID == secondID {
+		return nil, fmt.Errorf("cannot merge an entry with itself")
+	}
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	earlier, err := getEntryTx(tx, firstID)
+	if err != nil {
+		return nil, err
+	}
+
+	later, err := getEntryTx(tx, secondID)
+	if err != nil {
+		return nil, err
+	}
+
+	if later.StartTime.Before(earlier.StartTime) {
+		earlier, later = later, earlier
+	}
+
+	if err := CheckMergeable(earlier, later); err != nil {
+		return nil, err
+	}
 
+	var between int
+	err = tx.QueryRow(
+		"SELECT COUNT(*) FROM time_entries WHERE start_time > ? AND start_time < ? AND id NOT IN (?, ?)",
+		earlier.StartTime.UTC(),
+		later.StartTime.UTC(),
+		earlier.ID,
+		later.ID,
+	).Scan(&between)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for entries in between: %w", err)
+	}
+
+	if between > 0 {
+		return nil, fmt.Errorf("entries #%d and #%d are not adjacent: %d other entries lie between them", earlier.ID, later.ID, between)
+	}
+
+	description := earlier.Description
+	if description == "" {
+		description = later.Description
+	} else if later.Description != "" && later.Description != earlier.Description {
+		description = earlier.Description + "; " + later.Description
+	}
+
+	notes := earlier.Notes
+	if notes == "" {
+		notes = later.Notes
+	} else if later.Notes != "" && later.Notes != earlier.Notes {
+		notes = earlier.Notes + "\n\n" + later.Notes
+	}
+
+	issueKey := earlier.IssueKey
+	if issueKey == "" {
+		issueKey = later.IssueKey
+	}
+
+	var endTime sql.NullTime
+	if later.EndTime != nil {
+		end := *later.EndTime
+		if earlier.EndTime.After(end) {
+			end = *earlier.EndTime
+		}
+		endTime = sql.NullTime{Time: end.UTC(), Valid: true}
+	}
+
+	_, err = tx.Exec(
+		"UPDATE time_entries SET end_time = ?, description = ?, tags = ?, issue_key = ?, notes = ? WHERE id = ?",
+		endTime,
+		description,
+		joinTags(append(append([]string{}, earlier.Tags...), later.Tags...)),
+		nullString(issueKey),
+		nullString(notes),
+		earlier.ID,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query entries: %w", err)
+		return nil, fmt.Errorf("failed to update entry: %w", err)
 	}
 
-	defer rows.Close()
-
-	var entries []*TimeEntry
+	// a commit linked to both entries stays linked once
+	if _, err := tx.Exec("UPDATE OR IGNORE entry_commits SET entry_id = ? WHERE entry_id = ?", earlier.ID, later.ID); err != nil {
+		return nil, fmt.Errorf("failed to move entry commits: %w", err)
+	}
 
-	for rows.Next() {
-		var entry TimeEntry
-		var endTime sql.NullTime
-		var hourlyRate sql.NullFloat64
-		var milestoneName sql.NullString
+	if _, err := tx.Exec("DELETE FROM entry_commits WHERE entry_id = ?", later.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete entry commits: %w", err)
+	}
 
-		err := rows.Scan(&entry.ID, &entry.ProjectName, &entry.StartTime, &endTime, &entry.Description, &hourlyRate, &milestoneName)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan entry: %w", err)
-		}
+	if _, err := tx.Exec("DELETE FROM time_entries WHERE id = ?", later.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete entry: %w", err)
+	}
 
-		if endTime.Valid {
-			entry.EndTime = &endTime.Time
-		}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
 
-		if hourlyRate.Valid {
-			entry.HourlyRate = &hourlyRate.Float64
-		}
+	return d.GetEntry(earlier.ID)
+}
 
-		if milestoneName.Valid {
-			entry.MilestoneName = &milestoneName.String
-		}
+// CheckMergeable reports why two entries, given in start order, can't be
+// merged without losing their project, rate, milestone or billable flag.
+func CheckMergeable(earlier, later *TimeEntry) error {
+	if earlier.ProjectName != later.ProjectName {
+		return fmt.Errorf("entries belong to different projects ('%s' and '%s')", earlier.ProjectName, later.ProjectName)
+	}
 
-		entries = append(entries, &entry)
+	if earlier.IsRunning() {
+		return fmt.Errorf("entry #%d is still running, only the later entry can be running", earlier.ID)
 	}
 
-	return entries, nil
-}
+	if !sameRate(earlier.HourlyRate, later.HourlyRate) {
+		return fmt.Errorf("entries have different hourly rates")
+	}
 
-func (d *Database) UpdateTimeEntry(id int64, entry *TimeEntry) error {
-	startTimeUTC := entry.StartTime.UTC()
+	if !sameID(earlier.MilestoneID, later.MilestoneID) {
+		return fmt.Errorf("entries are assigned to different milestones")
+	}
 
-	var endTime sql.NullTime
-	if entry.EndTime != nil {
-		endTime = sql.NullTime{Time: entry.EndTime.UTC(), Valid: true}
+	if earlier.Billable != later.Billable {
+		return fmt.Errorf("only one of the entries is billable")
 	}
 
-	var hourlyRate sql.NullFloat64
-	if entry.HourlyRate != nil {
-		hourlyRate = sql.NullFloat64{Float64: *entry.HourlyRate, Valid: true}
+	return nil
+}
+
+func sameRate(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
 	}
 
-	var milestoneName sql.NullString
-	if entry.MilestoneName != nil {
-		milestoneName = sql.NullString{String: *entry.MilestoneName, Valid: true}
+	return *a == *b
+}
+
+func sameID(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
 	}
 
-	_, err := d.db.Exec(`
-		UPDATE time_entries
-		SET project_name = ?, start_time = ?, end_time = ?, description = ?, hourly_rate = ?, milestone_name = ?
-		WHERE id = ?
-	`, entry.ProjectName, startTimeUTC, endTime, entry.Description, hourlyRate, milestoneName, id)
+	return *a == *b
+}
 
+// AddEntryCommit links a git commit to an entry. Recording the same commit
+// twice for an entry is a no-op.
+func (d *Database) AddEntryCommit(entryID int64, sha, message string, committedAt time.Time) error {
+	_, err := d.db.Exec(
+		"INSERT OR IGNORE INTO entry_commits (entry_id, sha, message, committed_at) VALUES (?, ?, ?, ?)",
+		entryID,
+		sha,
+		message,
+		committedAt.UTC(),
+	)
 	if err != nil {
-		return fmt.Errorf("failed to update entry: %w", err)
+		return fmt.Errorf("failed to add entry commit: %w", err)
 	}
 
 	return nil
 }
 
-func (d *Database) DeleteTimeEntry(id int64) error {
-	_, err := d.db.Exec("DELETE FROM time_entries WHERE id = ?", id)
+// GetEntryCommits returns the commits linked to an entry, oldest first.
+func (d *Database) GetEntryCommits(entryID int64) ([]*EntryCommit, error) {
+	rows, err := d.db.Query(`
+		SELECT id, entry_id, sha, message, committed_at
+		FROM entry_commits
+		WHERE entry_id = ?
+		ORDER BY committed_at ASC, id ASC
+	`, entryID)
 	if err != nil {
-		return fmt.Errorf("failed to delete entry: %w", err)
+		return nil, fmt.Errorf("failed to query entry commits: %w", err)
+	}
+
+	defer rows.Close()
+
+	var commits []*EntryCommit
+	for rows.Next() {
+		var commit EntryCommit
+		var message sql.NullString
+
+		if err := rows.Scan(&commit.ID, &commit.EntryID, &commit.SHA, &message, &commit.CommittedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan entry commit: %w", err)
+		}
+
+		commit.Message = message.String
+		commits = append(commits, &commit)
+	}
+
+	return commits, nil
+}
+
+// LoadEntryCommits fills in the Commits field of each entry.
+func (d *Database) LoadEntryCommits(entries []*TimeEntry) error {
+	for _, entry := range entries {
+		commits, err := d.GetEntryCommits(entry.ID)
+		if err != nil {
+			return err
+		}
+
+		entry.Commits = commits
 	}
+
 	return nil
 }
 
 func (d *Database) CreateMilestone(projectName, name string) (*Milestone, error) {
+	now := time.Now().UTC()
+
 	result, err := d.db.Exec(
-		"INSERT INTO milestones (project_name, name, start_time) VALUES (?, ?, ?)",
+		"INSERT INTO milestones (project_name, name, start_time, activated_at) VALUES (?, ?, ?, ?)",
 		projectName,
 		name,
-		time.Now().UTC(),
+		now,
+		now,
 	)
 
 	if err != nil {
@@ -670,12 +1290,14 @@ func (d *Database) GetMilestone(id int64) (*Milestone, error) {
 	return &milestone, nil
 }
 
+// GetActiveMilestoneForProject returns the milestone new entries are tagged with.
+// When several milestones are open, the most recently started or switched-to one wins.
 func (d *Database) GetActiveMilestoneForProject(projectName string) (*Milestone, error) {
 	var milestone Milestone
 	var endTime sql.NullTime
 
 	err := d.db.QueryRow(
-		"SELECT id, project_name, name, start_time, end_time FROM milestones WHERE project_name = ? AND end_time IS NULL ORDER BY start_time DESC LIMIT 1",
+		"SELECT id, project_name, name, start_time, end_time FROM milestones WHERE project_name = ? AND end_time IS NULL ORDER BY COALESCE(activated_at, start_time) DESC LIMIT 1",
 		projectName,
 	).Scan(&milestone.ID, &milestone.ProjectName, &milestone.Name, &milestone.StartTime, &endTime)
 
@@ -794,46 +1416,405 @@ func (d *Database) FinishMilestone(id int64) error {
 	return nil
 }
 
-func (d *Database) GetEntriesByMilestone(projectName, milestoneName string) ([]*TimeEntry, error) {
-	rows, err := d.db.Query(
-		"SELECT id, project_name, start_time, end_time, description, hourly_rate, milestone_name FROM time_entries WHERE project_name = ? AND milestone_name = ? ORDER BY start_time DESC",
-		projectName,
-		milestoneName,
+// ReopenMilestone clears the end time of a finished milestone and makes it the
+// active milestone for its project.
+func (d *Database) ReopenMilestone(id int64) error {
+	_, err := d.db.Exec(
+		"UPDATE milestones SET end_time = NULL, activated_at = ? WHERE id = ?",
+		time.Now().UTC(),
+		id,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to reopen milestone: %w", err)
+	}
+
+	return nil
+}
+
+// SwitchMilestone makes an open milestone the active one for its project
+// without finishing the previously active milestone.
+func (d *Database) SwitchMilestone(id int64) error {
+	_, err := d.db.Exec(
+		"UPDATE milestones SET activated_at = ? WHERE id = ? AND end_time IS NULL",
+		time.Now().UTC(),
+		id,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to switch milestone: %w", err)
+	}
+
+	return nil
+}
+
+// RenameMilestone changes a milestone's name. Entries reference milestones by
+// ID, so they pick up the new name automatically.
+func (d *Database) RenameMilestone(id int64, newName string) error {
+	_, err := d.db.Exec(
+		"UPDATE milestones SET name = ? WHERE id = ?",
+		newName,
+		id,
 	)
 
+	if err != nil {
+		return fmt.Errorf("failed to rename milestone: %w", err)
+	}
+
+	return nil
+}
+
+func (d *Database) GetEntriesByMilestone(projectName, milestoneName string) ([]*TimeEntry, error) {
+	entries, err := d.queryEntries(`
+		SELECT `+entryColumns+`
+		`+entryFrom+`
+		WHERE e.project_name = ? AND m.name = ?
+		ORDER BY e.start_time DESC
+	`, projectName, milestoneName)
+
 	if err != nil {
 		return nil, fmt.Errorf("failed to get entries by milestone: %w", err)
 	}
-	defer rows.Close()
 
-	var entries []*TimeEntry
-	for rows.Next() {
-		var entry TimeEntry
-		var endTime sql.NullTime
-		var hourlyRate sql.NullFloat64
-		var milestoneName sql.NullString
+	return entries, nil
+}
+
+func (d *Database) GetEntriesByMilestoneID(milestoneID int64) ([]*TimeEntry, error) {
+	entries, err := d.queryEntries(`
+		SELECT `+entryColumns+`
+		`+entryFrom+`
+		WHERE e.milestone_id = ?
+		ORDER BY e.start_time DESC
+	`, milestoneID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entries by milestone: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ProjectExists reports whether any entry or milestone uses projectName.
+func (d *Database) ProjectExists(projectName string) (bool, error) {
+	var exists bool
+	err := d.db.QueryRow(`
+		SELECT EXISTS(SELECT 1 FROM time_entries WHERE project_name = ?)
+			OR EXISTS(SELECT 1 FROM milestones WHERE project_name = ?)
+	`, projectName, projectName).Scan(&exists)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to check project: %w", err)
+	}
+
+	return exists, nil
+}
+
+// ProjectMergeResult summarizes the rows changed by MergeProjects.
+type ProjectMergeResult struct {
+	EntriesMoved     int64
+	ExpensesMoved    int64
+	TopUpsMoved      int64
+	RatesMoved       int64
+	RatesDropped     int64
+	MilestonesMoved  int64
+	MilestonesMerged []string
+}
+
+// MergeProjects moves every entry and milestone of source into target in a
+// single transaction. A milestone name used by both projects is combined into
+// target's milestone, spanning both and staying open if either was open.
+// Source's rate schedule moves too, unless target has one of its own, which
+// then wins and source's rates are dropped.
+// beforeCommit, when non-nil, runs just before the commit so callers can make
+// dependent changes (e.g. config files) and roll everything back on failure.
+func (d *Database) MergeProjects(source, target string, beforeCommit func() error) (*ProjectMergeResult, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	sourceMilestones, err := projectMilestonesTx(tx, source)
+	if err != nil {
+		return nil, err
+	}
+
+	targetMilestones, err := projectMilestonesTx(tx, target)
+	if err != nil {
+		return nil, err
+	}
 
-		err := rows.Scan(&entry.ID, &entry.ProjectName, &entry.StartTime, &endTime, &entry.Description, &hourlyRate, &milestoneName)
+	var targetExists bool
+	err = tx.QueryRow(`
+		SELECT EXISTS(SELECT 1 FROM time_entries WHERE project_name = ?)
+			OR EXISTS(SELECT 1 FROM milestones WHERE project_name = ?)
+	`, target, target).Scan(&targetExists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check project: %w", err)
+	}
+
+	result := &ProjectMergeResult{}
+
+	var targetHasRates bool
+	err = tx.QueryRow("SELECT EXISTS(SELECT 1 FROM rates WHERE project_name = ?)", target).Scan(&targetHasRates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check rates: %w", err)
+	}
+
+	// schedules can't be combined, so target's rates win when it has any
+	if targetHasRates {
+		dropped, err := tx.Exec("DELETE FROM rates WHERE project_name = ?", source)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan entry: %w", err)
+			return nil, fmt.Errorf("failed to drop rates: %w", err)
 		}
+		result.RatesDropped, _ = dropped.RowsAffected()
+	} else {
+		moved, err := tx.Exec("UPDATE rates SET project_name = ? WHERE project_name = ?", target, source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to move rates: %w", err)
+		}
+		result.RatesMoved, _ = moved.RowsAffected()
+	}
 
-		if endTime.Valid {
-			entry.EndTime = &endTime.Time
+	for _, sourceMilestone := range sourceMilestones {
+		var targetMilestone *mergeMilestone
+		for _, candidate := range targetMilestones {
+			if candidate.name == sourceMilestone.name {
+				targetMilestone = candidate
+				break
+			}
+		}
+
+		if targetMilestone == nil {
+			continue
+		}
+
+		startTime := targetMilestone.startTime
+		if sourceMilestone.startTime.Before(startTime) {
+			startTime = sourceMilestone.startTime
+		}
+
+		var endTime sql.NullTime
+		if sourceMilestone.endTime.Valid && targetMilestone.endTime.Valid {
+			endTime = targetMilestone.endTime
+			if sourceMilestone.endTime.Time.After(endTime.Time) {
+				endTime = sourceMilestone.endTime
+			}
+		}
+
+		activatedAt := targetMilestone.activatedAt
+		if sourceMilestone.activatedAt.Valid && (!activatedAt.Valid || sourceMilestone.activatedAt.Time.After(activatedAt.Time)) {
+			activatedAt = sourceMilestone.activatedAt
+		}
+
+		if _, err := tx.Exec("UPDATE time_entries SET milestone_id = ? WHERE milestone_id = ?", targetMilestone.id, sourceMilestone.id); err != nil {
+			return nil, fmt.Errorf("failed to move milestone entries: %w", err)
+		}
+
+		if _, err := tx.Exec("UPDATE rates SET milestone_id = ? WHERE milestone_id = ?", targetMilestone.id, sourceMilestone.id); err != nil {
+			return nil, fmt.Errorf("failed to move milestone rates: %w", err)
 		}
 
-		if hourlyRate.Valid {
-			entry.HourlyRate = &hourlyRate.Float64
+		if _, err := tx.Exec(
+			"UPDATE milestones SET start_time = ?, end_time = ?, activated_at = ? WHERE id = ?",
+			startTime, endTime, activatedAt, targetMilestone.id,
+		); err != nil {
+			return nil, fmt.Errorf("failed to merge milestone: %w", err)
 		}
 
-		if milestoneName.Valid {
-			entry.MilestoneName = &milestoneName.String
+		if _, err := tx.Exec("DELETE FROM milestones WHERE id = ?", sourceMilestone.id); err != nil {
+			return nil, fmt.Errorf("failed to merge milestone: %w", err)
 		}
 
-		entries = append(entries, &entry)
+		result.MilestonesMerged = append(result.MilestonesMerged, sourceMilestone.name)
 	}
 
-	return entries, nil
+	moved, err := tx.Exec("UPDATE milestones SET project_name = ? WHERE project_name = ?", target, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move milestones: %w", err)
+	}
+	result.MilestonesMoved, _ = moved.RowsAffected()
+
+	moved, err = tx.Exec("UPDATE time_entries SET project_name = ? WHERE project_name = ?", target, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move entries: %w", err)
+	}
+	result.EntriesMoved, _ = moved.RowsAffected()
+
+	moved, err = tx.Exec("UPDATE expenses SET project_name = ? WHERE project_name = ?", target, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move expenses: %w", err)
+	}
+	result.ExpensesMoved, _ = moved.RowsAffected()
+
+	moved, err = tx.Exec("UPDATE retainers SET project_name = ? WHERE project_name = ?", target, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move retainer top-ups: %w", err)
+	}
+	result.TopUpsMoved, _ = moved.RowsAffected()
+
+	// a renamed project stays archived; when merging, the target's state wins
+	if targetExists {
+		_, err = tx.Exec("DELETE FROM archived_projects WHERE project_name = ?", source)
+	} else {
+		_, err = tx.Exec("UPDATE archived_projects SET project_name = ? WHERE project_name = ?", target, source)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to move archive state: %w", err)
+	}
+
+	if beforeCommit != nil {
+		if err := beforeCommit(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// ProjectDeleteResult summarizes the rows removed by DeleteProject.
+type ProjectDeleteResult struct {
+	EntriesDeleted    int64
+	MilestonesDeleted int64
+	ExpensesDeleted   int64
+	TopUpsDeleted     int64
+}
+
+// DeleteProject removes every entry (with its linked commits) and milestone of
+// a project, and its archive state, rates, expenses and retainer top-ups, in
+// a single transaction.
+func (d *Database) DeleteProject(projectName string) (*ProjectDeleteResult, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec("DELETE FROM entry_commits WHERE entry_id IN (SELECT id FROM time_entries WHERE project_name = ?)", projectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete entry commits: %w", err)
+	}
+
+	result := &ProjectDeleteResult{}
+
+	deleted, err := tx.Exec("DELETE FROM time_entries WHERE project_name = ?", projectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete entries: %w", err)
+	}
+	result.EntriesDeleted, _ = deleted.RowsAffected()
+
+	deleted, err = tx.Exec("DELETE FROM milestones WHERE project_name = ?", projectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete milestones: %w", err)
+	}
+	result.MilestonesDeleted, _ = deleted.RowsAffected()
+
+	if _, err := tx.Exec("DELETE FROM archived_projects WHERE project_name = ?", projectName); err != nil {
+		return nil, fmt.Errorf("failed to delete archive state: %w", err)
+	}
+
+	if _, err := tx.Exec("DELETE FROM rates WHERE project_name = ?", projectName); err != nil {
+		return nil, fmt.Errorf("failed to delete rates: %w", err)
+	}
+
+	deleted, err = tx.Exec("DELETE FROM expenses WHERE project_name = ?", projectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete expenses: %w", err)
+	}
+	result.ExpensesDeleted, _ = deleted.RowsAffected()
+
+	deleted, err = tx.Exec("DELETE FROM retainers WHERE project_name = ?", projectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete retainer top-ups: %w", err)
+	}
+	result.TopUpsDeleted, _ = deleted.RowsAffected()
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// ArchiveProject hides a project from pickers and default stats. Archiving an
+// archived project is a no-op.
+func (d *Database) ArchiveProject(projectName string) error {
+	_, err := d.db.Exec(
+		"INSERT OR IGNORE INTO archived_projects (project_name, archived_at) VALUES (?, ?)",
+		projectName,
+		time.Now().UTC(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to archive project: %w", err)
+	}
+
+	return nil
+}
+
+func (d *Database) UnarchiveProject(projectName string) error {
+	_, err := d.db.Exec("DELETE FROM archived_projects WHERE project_name = ?", projectName)
+	if err != nil {
+		return fmt.Errorf("failed to unarchive project: %w", err)
+	}
+
+	return nil
+}
+
+// GetArchivedProjects returns the archived project names mapped to when they
+// were archived.
+func (d *Database) GetArchivedProjects() (map[string]time.Time, error) {
+	rows, err := d.db.Query("SELECT project_name, archived_at FROM archived_projects")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archived projects: %w", err)
+	}
+	defer rows.Close()
+
+	archived := make(map[string]time.Time)
+	for rows.Next() {
+		var projectName string
+		var archivedAt time.Time
+		if err := rows.Scan(&projectName, &archivedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan archived project: %w", err)
+		}
+		archived[projectName] = archivedAt
+	}
+
+	return archived, rows.Err()
+}
+
+type mergeMilestone struct {
+	id          int64
+	name        string
+	startTime   time.Time
+	endTime     sql.NullTime
+	activatedAt sql.NullTime
+}
+
+func projectMilestonesTx(tx *sql.Tx, projectName string) ([]*mergeMilestone, error) {
+	rows, err := tx.Query(
+		"SELECT id, name, start_time, end_time, activated_at FROM milestones WHERE project_name = ?",
+		projectName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get milestones: %w", err)
+	}
+	defer rows.Close()
+
+	var milestones []*mergeMilestone
+	for rows.Next() {
+		var milestone mergeMilestone
+		if err := rows.Scan(&milestone.id, &milestone.name, &milestone.startTime, &milestone.endTime, &milestone.activatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan milestone: %w", err)
+		}
+		milestones = append(milestones, &milestone)
+	}
+
+	return milestones, rows.Err()
 }
 
 func (d *Database) Close() error {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	_ "modernc.org/sqlite"
 )
 
@@ -22,7 +23,12 @@ func setupTestDB(t *testing.T) *Database {
 			end_time DATETIME,
 			description TEXT,
 			hourly_rate REAL,
-			milestone_name TEXT
+			milestone_name TEXT,
+			milestone_id INTEGER,
+			tags TEXT,
+			issue_key TEXT,
+			notes TEXT,
+			billable INTEGER NOT NULL DEFAULT 1
 		)
 	`)
 	assert.NoError(t, err)
@@ -34,11 +40,83 @@ func setupTestDB(t *testing.T) *Database {
 			name TEXT NOT NULL,
 			start_time DATETIME NOT NULL,
 			end_time DATETIME,
+			activated_at DATETIME,
 			UNIQUE(project_name, name)
 		)
 	`)
 	assert.NoError(t, err)
 
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS entry_commits (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			entry_id INTEGER NOT NULL,
+			sha TEXT NOT NULL,
+			message TEXT,
+			committed_at DATETIME NOT NULL,
+			UNIQUE(entry_id, sha)
+		)
+	`)
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS archived_projects (
+			project_name TEXT PRIMARY KEY,
+			archived_at DATETIME NOT NULL
+		)
+	`)
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS rates (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			project_name TEXT NOT NULL,
+			milestone_id INTEGER REFERENCES milestones(id),
+			tag TEXT,
+			hourly_rate REAL NOT NULL,
+			effective_from DATETIME NOT NULL
+		)
+	`)
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS fx_rates (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			base TEXT NOT NULL,
+			quote TEXT NOT NULL,
+			rate REAL NOT NULL,
+			date DATETIME NOT NULL,
+			UNIQUE(base, quote, date)
+		)
+	`)
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS expenses (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			project_name TEXT NOT NULL,
+			date DATETIME NOT NULL,
+			amount REAL NOT NULL,
+			currency TEXT NOT NULL,
+			category TEXT,
+			description TEXT,
+			receipt_path TEXT
+		)
+	`)
+	assert.NoError(t, err)
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS retainers (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			project_name TEXT NOT NULL,
+			date DATETIME NOT NULL,
+			hours REAL NOT NULL,
+			note TEXT
+		)
+	`)
+	assert.NoError(t, err)
+
+	assert.NoError(t, createSearchIndex(db))
+
 	return &Database{db: db}
 }
 
@@ -74,7 +152,7 @@ func TestCreateEntry(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			entry, err := db.CreateEntry(tt.projectName, tt.description, tt.hourlyRate, nil)
+			entry, err := db.CreateEntry(tt.projectName, tt.description, tt.hourlyRate, nil, true)
 
 			assert.NoError(t, err)
 			assert.NotNil(t, entry)
@@ -101,7 +179,7 @@ func TestCreateManualEntry(t *testing.T) {
 	endTime := time.Now().Add(-1 * time.Hour)
 	rate := 100.0
 
-	entry, err := db.CreateManualEntry("manual-project", "manual work", startTime, endTime, &rate, nil)
+	entry, err := db.CreateManualEntry("manual-project", "manual work", startTime, endTime, &rate, nil, true)
 
 	assert.NoError(t, err)
 	assert.NotNil(t, entry)
@@ -123,7 +201,7 @@ func TestGetRunningEntry(t *testing.T) {
 	assert.Nil(t, running)
 
 	// Create a running entry
-	entry, err := db.CreateEntry("test-project", "test", nil, nil)
+	entry, err := db.CreateEntry("test-project", "test", nil, nil, true)
 	assert.NoError(t, err)
 
 	// Should return the running entry
@@ -153,7 +231,7 @@ func TestGetLastStoppedEntry(t *testing.T) {
 	assert.Nil(t, stopped)
 
 	// Create and stop first entry
-	entry1, err := db.CreateEntry("project-1", "first task", nil, nil)
+	entry1, err := db.CreateEntry("project-1", "first task", nil, nil, true)
 	assert.NoError(t, err)
 	time.Sleep(10 * time.Millisecond)
 	err = db.StopEntry(entry1.ID)
@@ -168,7 +246,7 @@ func TestGetLastStoppedEntry(t *testing.T) {
 
 	// Create and stop second entry (more recent)
 	time.Sleep(10 * time.Millisecond)
-	entry2, err := db.CreateEntry("project-2", "second task", nil, nil)
+	entry2, err := db.CreateEntry("project-2", "second task", nil, nil, true)
 	assert.NoError(t, err)
 	time.Sleep(10 * time.Millisecond)
 	err = db.StopEntry(entry2.ID)
@@ -183,7 +261,7 @@ func TestGetLastStoppedEntry(t *testing.T) {
 	assert.Equal(t, "second task", stopped.Description)
 
 	// Create a running entry
-	entry3, err := db.CreateEntry("project-3", "running task", nil, nil)
+	entry3, err := db.CreateEntry("project-3", "running task", nil, nil, true)
 	assert.NoError(t, err)
 
 	// Should still return entry2, not the running entry3
@@ -204,7 +282,7 @@ func TestGetLastStoppedEntryByProject(t *testing.T) {
 	assert.Nil(t, stopped)
 
 	// Create and stop first entry for project-1
-	entry1, err := db.CreateEntry("project-1", "first task", nil, nil)
+	entry1, err := db.CreateEntry("project-1", "first task", nil, nil, true)
 	assert.NoError(t, err)
 	time.Sleep(10 * time.Millisecond)
 	err = db.StopEntry(entry1.ID)
@@ -220,7 +298,7 @@ func TestGetLastStoppedEntryByProject(t *testing.T) {
 
 	// Create and stop entry for project-2 (more recent globally)
 	time.Sleep(10 * time.Millisecond)
-	entry2, err := db.CreateEntry("project-2", "second task", nil, nil)
+	entry2, err := db.CreateEntry("project-2", "second task", nil, nil, true)
 	assert.NoError(t, err)
 	time.Sleep(10 * time.Millisecond)
 	err = db.StopEntry(entry2.ID)
@@ -243,7 +321,7 @@ func TestGetLastStoppedEntryByProject(t *testing.T) {
 
 	// Create another stopped entry for project-1 (most recent for that project)
 	time.Sleep(10 * time.Millisecond)
-	entry3, err := db.CreateEntry("project-1", "third task", nil, nil)
+	entry3, err := db.CreateEntry("project-1", "third task", nil, nil, true)
 	assert.NoError(t, err)
 	time.Sleep(10 * time.Millisecond)
 	err = db.StopEntry(entry3.ID)
@@ -258,7 +336,7 @@ func TestGetLastStoppedEntryByProject(t *testing.T) {
 	assert.Equal(t, "third task", stopped.Description)
 
 	// Create a running entry for project-1
-	entry4, err := db.CreateEntry("project-1", "running task", nil, nil)
+	entry4, err := db.CreateEntry("project-1", "running task", nil, nil, true)
 	assert.NoError(t, err)
 
 	// Should still return entry3 (last stopped), not the running entry4
@@ -278,7 +356,7 @@ func TestStopEntry(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
 
-	entry, err := db.CreateEntry("test-project", "test", nil, nil)
+	entry, err := db.CreateEntry("test-project", "test", nil, nil, true)
 	assert.NoError(t, err)
 	assert.Nil(t, entry.EndTime)
 
@@ -296,7 +374,7 @@ func TestGetEntry(t *testing.T) {
 	defer db.Close()
 
 	rate := 75.5
-	created, err := db.CreateEntry("test-project", "test description", &rate, nil)
+	created, err := db.CreateEntry("test-project", "test description", &rate, nil, true)
 	assert.NoError(t, err)
 
 	// Get the entry
@@ -319,7 +397,7 @@ func TestGetEntries(t *testing.T) {
 
 	// Create multiple entries
 	for i := 0; i < 5; i++ {
-		_, err := db.CreateEntry("test-project", "", nil, nil)
+		_, err := db.CreateEntry("test-project", "", nil, nil, true)
 		assert.NoError(t, err)
 		time.Sleep(10 * time.Millisecond) // Ensure different timestamps
 	}
@@ -345,11 +423,11 @@ func TestGetEntriesByProject(t *testing.T) {
 	defer db.Close()
 
 	// Create entries for different projects
-	_, err := db.CreateEntry("project-a", "task 1", nil, nil)
+	_, err := db.CreateEntry("project-a", "task 1", nil, nil, true)
 	assert.NoError(t, err)
-	_, err = db.CreateEntry("project-b", "task 2", nil, nil)
+	_, err = db.CreateEntry("project-b", "task 2", nil, nil, true)
 	assert.NoError(t, err)
-	_, err = db.CreateEntry("project-a", "task 3", nil, nil)
+	_, err = db.CreateEntry("project-a", "task 3", nil, nil, true)
 	assert.NoError(t, err)
 
 	// Get entries for project-a
@@ -381,11 +459,11 @@ func TestGetEntriesByDateRange(t *testing.T) {
 	twoDaysAgo := now.Add(-48 * time.Hour)
 
 	// Create entries with different start times
-	_, err := db.CreateManualEntry("project", "old", twoDaysAgo, twoDaysAgo.Add(1*time.Hour), nil, nil)
+	_, err := db.CreateManualEntry("project", "old", twoDaysAgo, twoDaysAgo.Add(1*time.Hour), nil, nil, true)
 	assert.NoError(t, err)
-	_, err = db.CreateManualEntry("project", "recent", yesterday, yesterday.Add(1*time.Hour), nil, nil)
+	_, err = db.CreateManualEntry("project", "recent", yesterday, yesterday.Add(1*time.Hour), nil, nil, true)
 	assert.NoError(t, err)
-	_, err = db.CreateManualEntry("project", "today", now.Add(-1*time.Hour), now, nil, nil)
+	_, err = db.CreateManualEntry("project", "today", now.Add(-1*time.Hour), now, nil, nil, true)
 	assert.NoError(t, err)
 
 	// Get entries from yesterday onwards
@@ -404,11 +482,11 @@ func TestGetAllProjects(t *testing.T) {
 	assert.Len(t, projects, 0)
 
 	// Create entries for different projects
-	_, err = db.CreateEntry("zebra-project", "", nil, nil)
+	_, err = db.CreateEntry("zebra-project", "", nil, nil, true)
 	assert.NoError(t, err)
-	_, err = db.CreateEntry("alpha-project", "", nil, nil)
+	_, err = db.CreateEntry("alpha-project", "", nil, nil, true)
 	assert.NoError(t, err)
-	_, err = db.CreateEntry("zebra-project", "", nil, nil) // Duplicate
+	_, err = db.CreateEntry("zebra-project", "", nil, nil, true) // Duplicate
 	assert.NoError(t, err)
 
 	// Get all projects
@@ -426,11 +504,11 @@ func TestGetProjectsWithCompletedEntries(t *testing.T) {
 	defer db.Close()
 
 	// Create running entry
-	_, err := db.CreateEntry("running-project", "", nil, nil)
+	_, err := db.CreateEntry("running-project", "", nil, nil, true)
 	assert.NoError(t, err)
 
 	// Create completed entry
-	entry, err := db.CreateEntry("completed-project", "", nil, nil)
+	entry, err := db.CreateEntry("completed-project", "", nil, nil, true)
 	assert.NoError(t, err)
 	err = db.StopEntry(entry.ID)
 	assert.NoError(t, err)
@@ -447,18 +525,18 @@ func TestGetCompletedEntriesByProject(t *testing.T) {
 	defer db.Close()
 
 	// Create running entry
-	_, err := db.CreateEntry("test-project", "running", nil, nil)
+	_, err := db.CreateEntry("test-project", "running", nil, nil, true)
 	assert.NoError(t, err)
 
 	// Create completed entries
-	entry1, err := db.CreateEntry("test-project", "completed 1", nil, nil)
+	entry1, err := db.CreateEntry("test-project", "completed 1", nil, nil, true)
 	assert.NoError(t, err)
 	err = db.StopEntry(entry1.ID)
 	assert.NoError(t, err)
 
 	time.Sleep(10 * time.Millisecond)
 
-	entry2, err := db.CreateEntry("test-project", "completed 2", nil, nil)
+	entry2, err := db.CreateEntry("test-project", "completed 2", nil, nil, true)
 	assert.NoError(t, err)
 	err = db.StopEntry(entry2.ID)
 	assert.NoError(t, err)
@@ -480,7 +558,7 @@ func TestUpdateTimeEntry(t *testing.T) {
 
 	// Create an entry
 	rate := 100.0
-	entry, err := db.CreateEntry("original-project", "original description", &rate, nil)
+	entry, err := db.CreateEntry("original-project", "original description", &rate, nil, true)
 	assert.NoError(t, err)
 
 	// Update the entry
@@ -508,12 +586,98 @@ func TestUpdateTimeEntry(t *testing.T) {
 	assert.Equal(t, newRate, *updated.HourlyRate)
 }
 
+func TestSetEntryTags(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	entry, err := db.CreateEntry("test-project", "focus", nil, nil, true)
+	assert.NoError(t, err)
+	assert.Empty(t, entry.Tags)
+
+	err = db.SetEntryTags(entry.ID, []string{"deep-work", " pomodoro ", "Deep-Work", ""})
+	assert.NoError(t, err)
+
+	tagged, err := db.GetEntry(entry.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"deep-work", "pomodoro"}, tagged.Tags)
+	assert.True(t, tagged.HasTag("Pomodoro"))
+	assert.False(t, tagged.HasTag("meeting"))
+
+	// UpdateTimeEntry keeps the tags it was given
+	tagged.Description = "updated"
+	err = db.UpdateTimeEntry(tagged.ID, tagged)
+	assert.NoError(t, err)
+
+	updated, err := db.GetEntry(entry.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"deep-work", "pomodoro"}, updated.Tags)
+
+	err = db.SetEntryTags(entry.ID, nil)
+	assert.NoError(t, err)
+
+	cleared, err := db.GetEntry(entry.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, cleared.Tags)
+}
+
+func TestSetEntryIssueKey(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	entry, err := db.CreateEntry("test-project", "Login form", nil, nil, true)
+	require.NoError(t, err)
+	assert.Empty(t, entry.IssueKey)
+
+	require.NoError(t, db.SetEntryIssueKey(entry.ID, "PROJ-123"))
+
+	updated, err := db.GetEntry(entry.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "PROJ-123", updated.IssueKey)
+
+	// UpdateTimeEntry keeps the issue key
+	updated.Description = "Login form v2"
+	require.NoError(t, db.UpdateTimeEntry(updated.ID, updated))
+
+	reloaded, err := db.GetEntry(entry.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "PROJ-123", reloaded.IssueKey)
+}
+
+func TestSetEntryNotes(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	entry, err := db.CreateEntry("test-project", "Login form", nil, nil, true)
+	require.NoError(t, err)
+	assert.Empty(t, entry.Notes)
+
+	notes := "Validated the form.\n\n- still needs error states"
+	require.NoError(t, db.SetEntryNotes(entry.ID, notes))
+
+	updated, err := db.GetEntry(entry.ID)
+	require.NoError(t, err)
+	assert.Equal(t, notes, updated.Notes)
+
+	// UpdateTimeEntry keeps the notes
+	updated.Description = "Login form v2"
+	require.NoError(t, db.UpdateTimeEntry(updated.ID, updated))
+
+	reloaded, err := db.GetEntry(entry.ID)
+	require.NoError(t, err)
+	assert.Equal(t, notes, reloaded.Notes)
+
+	require.NoError(t, db.SetEntryNotes(entry.ID, ""))
+	cleared, err := db.GetEntry(entry.ID)
+	require.NoError(t, err)
+	assert.Empty(t, cleared.Notes)
+}
+
 func TestDeleteTimeEntry(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
 
 	// Create an entry
-	entry, err := db.CreateEntry("test-project", "to be deleted", nil, nil)
+	entry, err := db.CreateEntry("test-project", "to be deleted", nil, nil, true)
 	assert.NoError(t, err)
 
 	// Delete it
@@ -570,6 +734,66 @@ func TestTimeEntryIsRunning(t *testing.T) {
 	}
 }
 
+func TestSetEntryBillable(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	rate := 100.0
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	entry, err := db.CreateManualEntry("test-project", "Support call", start, start.Add(90*time.Minute), &rate, nil, true)
+	require.NoError(t, err)
+	assert.True(t, entry.Billable)
+	assert.Equal(t, 150.0, entry.Earnings())
+
+	require.NoError(t, db.SetEntryBillable(entry.ID, false))
+
+	updated, err := db.GetEntry(entry.ID)
+	require.NoError(t, err)
+	assert.False(t, updated.Billable)
+	assert.False(t, updated.IsBilled())
+	assert.Equal(t, 0.0, updated.Earnings())
+
+	// UpdateTimeEntry keeps the flag it was given
+	updated.Description = "Internal support call"
+	require.NoError(t, db.UpdateTimeEntry(updated.ID, updated))
+
+	reloaded, err := db.GetEntry(entry.ID)
+	require.NoError(t, err)
+	assert.False(t, reloaded.Billable)
+}
+
+func TestCreateEntryBillable(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	running, err := db.CreateEntry("test-project", "Internal meeting", nil, nil, false)
+	require.NoError(t, err)
+	assert.False(t, running.Billable)
+
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	manual, err := db.CreateManualEntry("test-project", "Internal meeting", start, start.Add(time.Hour), nil, nil, false)
+	require.NoError(t, err)
+	assert.False(t, manual.Billable)
+}
+
+func TestTimeEntryEarnings(t *testing.T) {
+	rate := 80.0
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(2*time.Hour + 30*time.Minute)
+
+	billed := &TimeEntry{StartTime: start, EndTime: &end, HourlyRate: &rate, Billable: true}
+	assert.True(t, billed.IsBilled())
+	assert.Equal(t, 200.0, billed.Earnings())
+
+	nonBillable := &TimeEntry{StartTime: start, EndTime: &end, HourlyRate: &rate}
+	assert.False(t, nonBillable.IsBilled())
+	assert.Equal(t, 0.0, nonBillable.Earnings())
+
+	noRate := &TimeEntry{StartTime: start, EndTime: &end, Billable: true}
+	assert.False(t, noRate.IsBilled())
+	assert.Equal(t, 0.0, noRate.Earnings())
+}
+
 func TestTimeEntryRoundedHours(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -617,6 +841,95 @@ func TestTimeEntryRoundedHours(t *testing.T) {
 	}
 }
 
+func TestMilestoneRenameCascadesToEntries(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	milestone, err := db.CreateMilestone("test-project", "Sprint 1")
+	assert.NoError(t, err)
+
+	entry, err := db.CreateEntry("test-project", "tagged work", nil, &milestone.ID, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "Sprint 1", *entry.MilestoneName)
+
+	err = db.RenameMilestone(milestone.ID, "Sprint One")
+	assert.NoError(t, err)
+
+	updated, err := db.GetEntry(entry.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, milestone.ID, *updated.MilestoneID)
+	assert.Equal(t, "Sprint One", *updated.MilestoneName)
+
+	entries, err := db.GetEntriesByMilestone("test-project", "Sprint One")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	entries, err = db.GetEntriesByMilestone("test-project", "Sprint 1")
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestReopenMilestone(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	milestone, err := db.CreateMilestone("test-project", "Release 1")
+	assert.NoError(t, err)
+
+	err = db.FinishMilestone(milestone.ID)
+	assert.NoError(t, err)
+
+	active, err := db.GetActiveMilestoneForProject("test-project")
+	assert.NoError(t, err)
+	assert.Nil(t, active)
+
+	err = db.ReopenMilestone(milestone.ID)
+	assert.NoError(t, err)
+
+	active, err = db.GetActiveMilestoneForProject("test-project")
+	assert.NoError(t, err)
+	assert.NotNil(t, active)
+	assert.Equal(t, milestone.ID, active.ID)
+	assert.True(t, active.IsActive())
+}
+
+func TestSwitchMilestone(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	first, err := db.CreateMilestone("test-project", "Feature A")
+	assert.NoError(t, err)
+
+	second, err := db.CreateMilestone("test-project", "Feature B")
+	assert.NoError(t, err)
+
+	active, err := db.GetActiveMilestoneForProject("test-project")
+	assert.NoError(t, err)
+	assert.Equal(t, second.ID, active.ID)
+
+	err = db.SwitchMilestone(first.ID)
+	assert.NoError(t, err)
+
+	active, err = db.GetActiveMilestoneForProject("test-project")
+	assert.NoError(t, err)
+	assert.Equal(t, first.ID, active.ID)
+
+	// switching does not finish the previous milestone
+	previous, err := db.GetMilestone(second.ID)
+	assert.NoError(t, err)
+	assert.True(t, previous.IsActive())
+
+	// finished milestones cannot be switched to
+	err = db.FinishMilestone(second.ID)
+	assert.NoError(t, err)
+	err = db.SwitchMilestone(second.ID)
+	assert.NoError(t, err)
+
+	active, err = db.GetActiveMilestoneForProject("test-project")
+	assert.NoError(t, err)
+	assert.Equal(t, first.ID, active.ID)
+}
+
 // Helper functions
 func floatPtr(f float64) *float64 {
 	return &f
@@ -625,3 +938,507 @@ func floatPtr(f float64) *float64 {
 func timePtr(t time.Time) *time.Time {
 	return &t
 }
+
+func TestOpenReadOnly(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("TMPO_DEV", "")
+
+	_, err := OpenReadOnly()
+	assert.ErrorIs(t, err, ErrNoDatabase)
+
+	db, err := Initialize()
+	require.NoError(t, err)
+	_, err = db.CreateEntry("test-project", "running", nil, nil, true)
+	require.NoError(t, err)
+	db.Close()
+
+	readOnly, err := OpenReadOnly()
+	require.NoError(t, err)
+	defer readOnly.Close()
+
+	running, err := readOnly.GetRunningEntry()
+	require.NoError(t, err)
+	require.NotNil(t, running)
+	assert.Equal(t, "test-project", running.ProjectName)
+
+	_, err = readOnly.CreateEntry("test-project", "should fail", nil, nil, true)
+	assert.Error(t, err)
+}
+
+func TestEntryCommits(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	entry, err := db.CreateEntry("test-project", "feature work", nil, nil, true)
+	require.NoError(t, err)
+
+	first := time.Now().Add(-10 * time.Minute)
+	second := time.Now()
+
+	require.NoError(t, db.AddEntryCommit(entry.ID, "abcdef1234567890", "Add login form", first))
+	require.NoError(t, db.AddEntryCommit(entry.ID, "1234567abcdef890", "Fix validation", second))
+	// recording the same commit again is ignored
+	require.NoError(t, db.AddEntryCommit(entry.ID, "abcdef1234567890", "Add login form", first))
+
+	commits, err := db.GetEntryCommits(entry.ID)
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	assert.Equal(t, "abcdef1", commits[0].ShortSHA())
+	assert.Equal(t, "Add login form", commits[0].Message)
+	assert.Equal(t, "Fix validation", commits[1].Message)
+
+	entries := []*TimeEntry{entry}
+	require.NoError(t, db.LoadEntryCommits(entries))
+	assert.Len(t, entries[0].Commits, 2)
+
+	require.NoError(t, db.DeleteTimeEntry(entry.ID))
+	commits, err = db.GetEntryCommits(entry.ID)
+	require.NoError(t, err)
+	assert.Empty(t, commits)
+}
+
+func TestMergeProjects(t *testing.T) {
+	t.Run("moves entries and merges colliding milestones", func(t *testing.T) {
+		db := setupTestDB(t)
+		defer db.Close()
+
+		sourceSprint, err := db.CreateMilestone("api", "Sprint 1")
+		require.NoError(t, err)
+		sourceOnly, err := db.CreateMilestone("api", "Hotfixes")
+		require.NoError(t, err)
+		targetSprint, err := db.CreateMilestone("api-server", "Sprint 1")
+		require.NoError(t, err)
+		require.NoError(t, db.FinishMilestone(targetSprint.ID))
+
+		sourceEntry, err := db.CreateEntry("api", "source work", nil, &sourceSprint.ID, true)
+		require.NoError(t, err)
+		_, err = db.CreateEntry("api", "hotfix", nil, &sourceOnly.ID, true)
+		require.NoError(t, err)
+		_, err = db.CreateEntry("api-server", "target work", nil, &targetSprint.ID, true)
+		require.NoError(t, err)
+
+		result, err := db.MergeProjects("api", "api-server", nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.EntriesMoved)
+		assert.Equal(t, int64(1), result.MilestonesMoved)
+		assert.Equal(t, []string{"Sprint 1"}, result.MilestonesMerged)
+
+		exists, err := db.ProjectExists("api")
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		moved, err := db.GetEntry(sourceEntry.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "api-server", moved.ProjectName)
+		assert.Equal(t, targetSprint.ID, *moved.MilestoneID)
+
+		// the merged milestone stays open because the source one was open
+		merged, err := db.GetMilestone(targetSprint.ID)
+		require.NoError(t, err)
+		assert.True(t, merged.IsActive())
+
+		sprintEntries, err := db.GetEntriesByMilestoneID(targetSprint.ID)
+		require.NoError(t, err)
+		assert.Len(t, sprintEntries, 2)
+
+		milestones, err := db.GetMilestonesByProject("api-server")
+		require.NoError(t, err)
+		assert.Len(t, milestones, 2)
+	})
+
+	t.Run("rolls back when beforeCommit fails", func(t *testing.T) {
+		db := setupTestDB(t)
+		defer db.Close()
+
+		_, err := db.CreateMilestone("old", "Sprint 1")
+		require.NoError(t, err)
+		_, err = db.CreateEntry("old", "work", nil, nil, true)
+		require.NoError(t, err)
+
+		_, err = db.MergeProjects("old", "new", func() error {
+			return assert.AnError
+		})
+		assert.ErrorIs(t, err, assert.AnError)
+
+		exists, err := db.ProjectExists("old")
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		exists, err = db.ProjectExists("new")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestArchiveProject(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	_, err := db.CreateManualEntry("active", "", time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour), nil, nil, true)
+	require.NoError(t, err)
+	_, err = db.CreateManualEntry("old-client", "", time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour), nil, nil, true)
+	require.NoError(t, err)
+
+	require.NoError(t, db.ArchiveProject("old-client"))
+	require.NoError(t, db.ArchiveProject("old-client"))
+
+	projects, err := db.GetAllProjects()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"active"}, projects)
+
+	projects, err = db.GetProjectsWithCompletedEntries()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"active"}, projects)
+
+	archived, err := db.GetArchivedProjects()
+	require.NoError(t, err)
+	assert.Contains(t, archived, "old-client")
+
+	// renaming keeps the project archived
+	_, err = db.MergeProjects("old-client", "former-client", nil)
+	require.NoError(t, err)
+	archived, err = db.GetArchivedProjects()
+	require.NoError(t, err)
+	assert.Contains(t, archived, "former-client")
+	assert.NotContains(t, archived, "old-client")
+
+	require.NoError(t, db.UnarchiveProject("former-client"))
+	projects, err = db.GetAllProjects()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"active", "former-client"}, projects)
+}
+
+func TestDeleteProject(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	milestone, err := db.CreateMilestone("doomed", "Sprint 1")
+	require.NoError(t, err)
+	entry, err := db.CreateManualEntry("doomed", "", time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour), nil, &milestone.ID, true)
+	require.NoError(t, err)
+	require.NoError(t, db.AddEntryCommit(entry.ID, "abcdef1234567890", "Fix bug", time.Now()))
+	require.NoError(t, db.ArchiveProject("doomed"))
+	kept, err := db.CreateManualEntry("kept", "", time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour), nil, nil, true)
+	require.NoError(t, err)
+
+	result, err := db.DeleteProject("doomed")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.EntriesDeleted)
+	assert.Equal(t, int64(1), result.MilestonesDeleted)
+
+	exists, err := db.ProjectExists("doomed")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	commits, err := db.GetEntryCommits(entry.ID)
+	require.NoError(t, err)
+	assert.Empty(t, commits)
+
+	archived, err := db.GetArchivedProjects()
+	require.NoError(t, err)
+	assert.Empty(t, archived)
+
+	stillThere, err := db.GetEntry(kept.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, stillThere)
+}
+
+func TestFindEntries(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	base := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	sprint, err := db.CreateMilestone("web", "Sprint 1")
+	require.NoError(t, err)
+
+	first, err := db.CreateManualEntry("web", "Fix login_form", base, base.Add(time.Hour), nil, &sprint.ID, true)
+	require.NoError(t, err)
+	require.NoError(t, db.SetEntryTags(first.ID, []string{"bug", "Überstunden"}))
+	second, err := db.CreateManualEntry("web", "Review", base.AddDate(0, 0, 1), base.AddDate(0, 0, 1).Add(time.Hour), nil, nil, true)
+	require.NoError(t, err)
+	_, err = db.CreateManualEntry("api", "Fix login", base.AddDate(0, 0, 7), base.AddDate(0, 0, 7).Add(time.Hour), nil, nil, true)
+	require.NoError(t, err)
+
+	ids := func(entries []*TimeEntry) []int64 {
+		var result []int64
+		for _, entry := range entries {
+			result = append(result, entry.ID)
+		}
+		return result
+	}
+
+	entries, err := db.FindEntries(EntryFilter{Project: "web"})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{first.ID, second.ID}, ids(entries))
+
+	entries, err = db.FindEntries(EntryFilter{Milestone: "Sprint 1"})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{first.ID}, ids(entries))
+
+	entries, err = db.FindEntries(EntryFilter{Tag: "BUG"})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{first.ID}, ids(entries))
+
+	entries, err = db.FindEntries(EntryFilter{Tag: "überstunden"})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{first.ID}, ids(entries), "tags ignore case beyond ASCII")
+
+	entries, err = db.FindEntries(EntryFilter{Description: "LOGIN_"})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{first.ID}, ids(entries), "LIKE wildcards are escaped")
+
+	start, end := base.AddDate(0, 0, 1), base.AddDate(0, 0, 8)
+	entries, err = db.FindEntries(EntryFilter{Start: &start, End: &end})
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, second.ID, entries[0].ID)
+
+	assert.True(t, EntryFilter{}.IsEmpty())
+	assert.False(t, EntryFilter{Tag: "bug"}.IsEmpty())
+}
+
+func TestUpdateEntries(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	now := time.Now()
+	oldSprint, err := db.CreateMilestone("old", "Sprint 1")
+	require.NoError(t, err)
+	newSprint, err := db.CreateMilestone("new", "Sprint 1")
+	require.NoError(t, err)
+	release, err := db.CreateMilestone("new", "Release")
+	require.NoError(t, err)
+	otherMilestone, err := db.CreateMilestone("old", "Only in old")
+	require.NoError(t, err)
+
+	kept, err := db.CreateManualEntry("old", "", now.Add(-3*time.Hour), now.Add(-2*time.Hour), nil, &oldSprint.ID, true)
+	require.NoError(t, err)
+	dropped, err := db.CreateManualEntry("old", "", now.Add(-2*time.Hour), now.Add(-time.Hour), nil, &otherMilestone.ID, true)
+	require.NoError(t, err)
+
+	t.Run("moves entries and remaps milestones by name", func(t *testing.T) {
+		project := "new"
+		updated, err := db.UpdateEntries([]int64{kept.ID, dropped.ID}, EntryUpdate{Project: &project})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), updated)
+
+		entry, err := db.GetEntry(kept.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "new", entry.ProjectName)
+		require.NotNil(t, entry.MilestoneID)
+		assert.Equal(t, newSprint.ID, *entry.MilestoneID)
+
+		entry, err = db.GetEntry(dropped.ID)
+		require.NoError(t, err)
+		assert.Nil(t, entry.MilestoneID)
+	})
+
+	t.Run("sets and clears milestones", func(t *testing.T) {
+		name := "Release"
+		_, err := db.UpdateEntries([]int64{kept.ID}, EntryUpdate{Milestone: &name})
+		require.NoError(t, err)
+
+		entry, err := db.GetEntry(kept.ID)
+		require.NoError(t, err)
+		require.NotNil(t, entry.MilestoneID)
+		assert.Equal(t, release.ID, *entry.MilestoneID)
+
+		none := ""
+		_, err = db.UpdateEntries([]int64{kept.ID}, EntryUpdate{Milestone: &none})
+		require.NoError(t, err)
+
+		entry, err = db.GetEntry(kept.ID)
+		require.NoError(t, err)
+		assert.Nil(t, entry.MilestoneID)
+	})
+
+	t.Run("sets the billable flag", func(t *testing.T) {
+		billable := false
+		_, err := db.UpdateEntries([]int64{kept.ID}, EntryUpdate{Billable: &billable})
+		require.NoError(t, err)
+
+		entry, err := db.GetEntry(kept.ID)
+		require.NoError(t, err)
+		assert.False(t, entry.Billable)
+
+		// other updates leave it alone
+		project := "new"
+		_, err = db.UpdateEntries([]int64{kept.ID, dropped.ID}, EntryUpdate{Project: &project})
+		require.NoError(t, err)
+
+		entry, err = db.GetEntry(kept.ID)
+		require.NoError(t, err)
+		assert.False(t, entry.Billable)
+
+		entry, err = db.GetEntry(dropped.ID)
+		require.NoError(t, err)
+		assert.True(t, entry.Billable)
+	})
+
+	t.Run("rolls back when a milestone is missing", func(t *testing.T) {
+		project, name := "old", "Release"
+		_, err := db.UpdateEntries([]int64{kept.ID, dropped.ID}, EntryUpdate{Project: &project, Milestone: &name})
+		assert.Error(t, err)
+
+		entry, err := db.GetEntry(kept.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "new", entry.ProjectName)
+	})
+}
+
+func TestDeleteEntries(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	now := time.Now()
+	doomed, err := db.CreateManualEntry("web", "", now.Add(-2*time.Hour), now.Add(-time.Hour), nil, nil, true)
+	require.NoError(t, err)
+	require.NoError(t, db.AddEntryCommit(doomed.ID, "abcdef1234567890", "Fix bug", now))
+	kept, err := db.CreateManualEntry("web", "", now.Add(-time.Hour), now, nil, nil, true)
+	require.NoError(t, err)
+
+	deleted, err := db.DeleteEntries([]int64{doomed.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	_, err = db.GetEntry(doomed.ID)
+	assert.Error(t, err)
+
+	commits, err := db.GetEntryCommits(doomed.ID)
+	require.NoError(t, err)
+	assert.Empty(t, commits)
+
+	_, err = db.GetEntry(kept.ID)
+	assert.NoError(t, err)
+}
+
+func TestSplitEntry(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	start := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	rate := 80.0
+	milestone, err := db.CreateMilestone("web", "Sprint 1")
+	require.NoError(t, err)
+	entry, err := db.CreateManualEntry("web", "Login and review", start, start.Add(4*time.Hour), &rate, &milestone.ID, true)
+	require.NoError(t, err)
+	require.NoError(t, db.SetEntryTags(entry.ID, []string{"frontend"}))
+	require.NoError(t, db.SetEntryIssueKey(entry.ID, "WEB-1"))
+	require.NoError(t, db.SetEntryNotes(entry.ID, "Reviewed the login flow"))
+	require.NoError(t, db.SetEntryBillable(entry.ID, false))
+	require.NoError(t, db.AddEntryCommit(entry.ID, "aaaaaaa111", "Early", start.Add(time.Hour)))
+	require.NoError(t, db.AddEntryCommit(entry.ID, "bbbbbbb222", "Late", start.Add(3*time.Hour)))
+
+	t.Run("rejects times outside the entry", func(t *testing.T) {
+		_, _, err := db.SplitEntry(entry.ID, start, "")
+		assert.Error(t, err)
+		_, _, err = db.SplitEntry(entry.ID, start.Add(5*time.Hour), "")
+		assert.Error(t, err)
+	})
+
+	first, second, err := db.SplitEntry(entry.ID, start.Add(2*time.Hour), "Review")
+	require.NoError(t, err)
+
+	assert.Equal(t, entry.ID, first.ID)
+	assert.True(t, first.EndTime.Equal(start.Add(2*time.Hour)))
+	assert.Equal(t, "Login and review", first.Description)
+
+	assert.True(t, second.StartTime.Equal(start.Add(2*time.Hour)))
+	assert.True(t, second.EndTime.Equal(start.Add(4*time.Hour)))
+	assert.Equal(t, "Review", second.Description)
+	require.NotNil(t, second.HourlyRate)
+	assert.Equal(t, rate, *second.HourlyRate)
+	require.NotNil(t, second.MilestoneID)
+	assert.Equal(t, milestone.ID, *second.MilestoneID)
+	assert.Equal(t, []string{"frontend"}, second.Tags)
+	assert.Equal(t, "WEB-1", second.IssueKey)
+	assert.Equal(t, "Reviewed the login flow", second.Notes)
+	assert.False(t, second.Billable)
+
+	commits, err := db.GetEntryCommits(first.ID)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	assert.Equal(t, "Early", commits[0].Message)
+
+	commits, err = db.GetEntryCommits(second.ID)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	assert.Equal(t, "Late", commits[0].Message)
+}
+
+func TestMergeEntries(t *testing.T) {
+	start := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	rate := 80.0
+
+	t.Run("combines adjacent entries", func(t *testing.T) {
+		db := setupTestDB(t)
+		defer db.Close()
+
+		first, err := db.CreateManualEntry("web", "Login", start, start.Add(time.Hour), &rate, nil, true)
+		require.NoError(t, err)
+		require.NoError(t, db.SetEntryTags(first.ID, []string{"frontend"}))
+		require.NoError(t, db.SetEntryNotes(first.ID, "Form done"))
+		second, err := db.CreateManualEntry("web", "Review", start.Add(90*time.Minute), start.Add(3*time.Hour), &rate, nil, true)
+		require.NoError(t, err)
+		require.NoError(t, db.SetEntryTags(second.ID, []string{"review", "frontend"}))
+		require.NoError(t, db.SetEntryNotes(second.ID, "Left comments"))
+		require.NoError(t, db.AddEntryCommit(second.ID, "abcdef1234", "Fix", start.Add(2*time.Hour)))
+
+		// argument order doesn't matter
+		merged, err := db.MergeEntries(second.ID, first.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, first.ID, merged.ID)
+		assert.True(t, merged.StartTime.Equal(start))
+		assert.True(t, merged.EndTime.Equal(start.Add(3*time.Hour)))
+		assert.Equal(t, "Login; Review", merged.Description)
+		assert.Equal(t, []string{"frontend", "review"}, merged.Tags)
+		assert.Equal(t, "Form done\n\nLeft comments", merged.Notes)
+		require.NotNil(t, merged.HourlyRate)
+		assert.Equal(t, rate, *merged.HourlyRate)
+
+		commits, err := db.GetEntryCommits(merged.ID)
+		require.NoError(t, err)
+		assert.Len(t, commits, 1)
+
+		_, err = db.GetEntry(second.ID)
+		assert.Error(t, err)
+	})
+
+	t.Run("rejects entries that can't be merged", func(t *testing.T) {
+		db := setupTestDB(t)
+		defer db.Close()
+
+		other := 50.0
+		first, err := db.CreateManualEntry("web", "", start, start.Add(time.Hour), &rate, nil, true)
+		require.NoError(t, err)
+		between, err := db.CreateManualEntry("api", "", start.Add(time.Hour), start.Add(2*time.Hour), &rate, nil, true)
+		require.NoError(t, err)
+		third, err := db.CreateManualEntry("web", "", start.Add(2*time.Hour), start.Add(3*time.Hour), &rate, nil, true)
+		require.NoError(t, err)
+		differentRate, err := db.CreateManualEntry("web", "", start.Add(3*time.Hour), start.Add(4*time.Hour), &other, nil, true)
+		require.NoError(t, err)
+		nonBillable, err := db.CreateManualEntry("web", "", start.Add(4*time.Hour), start.Add(5*time.Hour), &other, nil, true)
+		require.NoError(t, err)
+		require.NoError(t, db.SetEntryBillable(nonBillable.ID, false))
+
+		_, err = db.MergeEntries(first.ID, between.ID)
+		assert.ErrorContains(t, err, "different projects")
+
+		_, err = db.MergeEntries(first.ID, third.ID)
+		assert.ErrorContains(t, err, "not adjacent")
+
+		_, err = db.MergeEntries(third.ID, differentRate.ID)
+		assert.ErrorContains(t, err, "hourly rates")
+
+		_, err = db.MergeEntries(differentRate.ID, nonBillable.ID)
+		assert.ErrorContains(t, err, "billable")
+
+		_, err = db.MergeEntries(first.ID, first.ID)
+		assert.Error(t, err)
+
+		entry, err := db.GetEntry(third.ID)
+		require.NoError(t, err)
+		assert.True(t, entry.EndTime.Equal(start.Add(3*time.Hour)))
+	})
+}
@@ -0,0 +1,184 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Expense is a cost billed to a project alongside its time, such as travel,
+// licenses or hosting.
+type Expense struct {
+	ID          int64
+	ProjectName string
+	Date        time.Time
+	Amount      float64
+	Currency    string
+	Category    string
+	Description string
+	ReceiptPath string
+}
+
+// ExpenseFilter narrows down the expenses returned by FindExpenses. Empty
+// fields match every expense.
+type ExpenseFilter struct {
+	Project  string
+	Category string
+	Start    *time.Time
+	End      *time.Time // exclusive
+}
+
+// AddExpense stores a new expense.
+func (d *Database) AddExpense(expense *Expense) (*Expense, error) {
+	if err := validateExpense(expense); err != nil {
+		return nil, err
+	}
+
+	result, err := d.db.Exec(
+		"INSERT INTO expenses (project_name, date, amount, currency, category, description, receipt_path) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		expense.ProjectName,
+		expense.Date.UTC(),
+		expense.Amount,
+		strings.ToUpper(strings.TrimSpace(expense.Currency)),
+		nullString(strings.TrimSpace(expense.Category)),
+		nullString(strings.TrimSpace(expense.Description)),
+		nullString(strings.TrimSpace(expense.ReceiptPath)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add expense: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return d.GetExpense(id)
+}
+
+// UpdateExpense replaces every field of an expense.
+func (d *Database) UpdateExpense(id int64, expense *Expense) error {
+	if err := validateExpense(expense); err != nil {
+		return err
+	}
+
+	_, err := d.db.Exec(
+		"UPDATE expenses SET project_name = ?, date = ?, amount = ?, currency = ?, category = ?, description = ?, receipt_path = ? WHERE id = ?",
+		expense.ProjectName,
+		expense.Date.UTC(),
+		expense.Amount,
+		strings.ToUpper(strings.TrimSpace(expense.Currency)),
+		nullString(strings.TrimSpace(expense.Category)),
+		nullString(strings.TrimSpace(expense.Description)),
+		nullString(strings.TrimSpace(expense.ReceiptPath)),
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update expense: %w", err)
+	}
+
+	return nil
+}
+
+func validateExpense(expense *Expense) error {
+	if strings.TrimSpace(expense.ProjectName) == "" {
+		return fmt.Errorf("an expense needs a project")
+	}
+	if expense.Amount <= 0 {
+		return fmt.Errorf("expense amount must be greater than 0")
+	}
+	if strings.TrimSpace(expense.Currency) == "" {
+		return fmt.Errorf("an expense needs a currency")
+	}
+
+	return nil
+}
+
+const expenseColumns = "id, project_name, date, amount, currency, category, description, receipt_path FROM expenses"
+
+func scanExpense(row rowScanner) (*Expense, error) {
+	var expense Expense
+	var category, description, receiptPath sql.NullString
+
+	err := row.Scan(&expense.ID, &expense.ProjectName, &expense.Date, &expense.Amount, &expense.Currency, &category, &description, &receiptPath)
+	if err != nil {
+		return nil, err
+	}
+
+	expense.Category = category.String
+	expense.Description = description.String
+	expense.ReceiptPath = receiptPath.String
+
+	return &expense, nil
+}
+
+// GetExpense returns an expense, or nil when it doesn't exist.
+func (d *Database) GetExpense(id int64) (*Expense, error) {
+	expense, err := scanExpense(d.db.QueryRow("SELECT "+expenseColumns+" WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expense: %w", err)
+	}
+
+	return expense, nil
+}
+
+// FindExpenses returns the expenses matching the filter, latest first.
+func (d *Database) FindExpenses(filter ExpenseFilter) ([]*Expense, error) {
+	query := "SELECT " + expenseColumns
+	var conditions []string
+	var args []any
+
+	if filter.Project != "" {
+		conditions = append(conditions, "project_name = ?")
+		args = append(args, filter.Project)
+	}
+	if filter.Category != "" {
+		conditions = append(conditions, "category = ? COLLATE NOCASE")
+		args = append(args, strings.TrimSpace(filter.Category))
+	}
+	if filter.Start != nil {
+		conditions = append(conditions, "date >= ?")
+		args = append(args, filter.Start.UTC())
+	}
+	if filter.End != nil {
+		conditions = append(conditions, "date < ?")
+		args = append(args, filter.End.UTC())
+	}
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY date DESC, id DESC"
+
+	rows, err := d.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expenses: %w", err)
+	}
+	defer rows.Close()
+
+	var expenses []*Expense
+	for rows.Next() {
+		expense, err := scanExpense(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan expense: %w", err)
+		}
+		expenses = append(expenses, expense)
+	}
+
+	return expenses, rows.Err()
+}
+
+// DeleteExpense removes an expense and reports whether it existed.
+func (d *Database) DeleteExpense(id int64) (bool, error) {
+	result, err := d.db.Exec("DELETE FROM expenses WHERE id = ?", id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete expense: %w", err)
+	}
+
+	deleted, _ := result.RowsAffected()
+
+	return deleted > 0, nil
+}
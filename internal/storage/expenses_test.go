@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddExpense(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	jan := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)
+
+	hosting, err := db.AddExpense(&Expense{ProjectName: "web", Date: jan, Amount: 20, Currency: "usd", Category: "Hosting"})
+	require.NoError(t, err)
+	assert.Equal(t, "USD", hosting.Currency)
+	assert.Equal(t, "Hosting", hosting.Category)
+	assert.True(t, hosting.Date.Equal(jan))
+
+	_, err = db.AddExpense(&Expense{ProjectName: "web", Date: feb, Amount: 350, Currency: "EUR", Category: "travel", Description: "Train to Berlin", ReceiptPath: "receipts/train.pdf"})
+	require.NoError(t, err)
+	_, err = db.AddExpense(&Expense{ProjectName: "api", Date: feb, Amount: 99, Currency: "USD", Category: "licenses"})
+	require.NoError(t, err)
+
+	_, err = db.AddExpense(&Expense{ProjectName: "web", Date: feb, Amount: 0, Currency: "USD"})
+	assert.Error(t, err)
+
+	all, err := db.FindExpenses(ExpenseFilter{})
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+
+	web, err := db.FindExpenses(ExpenseFilter{Project: "web"})
+	require.NoError(t, err)
+	require.Len(t, web, 2)
+	assert.Equal(t, "Train to Berlin", web[0].Description)
+	assert.Equal(t, "receipts/train.pdf", web[0].ReceiptPath)
+
+	end := jan.AddDate(0, 1, 0)
+	january, err := db.FindExpenses(ExpenseFilter{Start: &jan, End: &end})
+	require.NoError(t, err)
+	require.Len(t, january, 1)
+	assert.Equal(t, hosting.ID, january[0].ID)
+
+	hostingOnly, err := db.FindExpenses(ExpenseFilter{Category: "hosting"})
+	require.NoError(t, err)
+	assert.Len(t, hostingOnly, 1)
+}
+
+func TestUpdateAndDeleteExpense(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	date := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	expense, err := db.AddExpense(&Expense{ProjectName: "web", Date: date, Amount: 20, Currency: "USD", Category: "hosting"})
+	require.NoError(t, err)
+
+	expense.Amount = 25
+	expense.Category = ""
+	require.NoError(t, db.UpdateExpense(expense.ID, expense))
+
+	updated, err := db.GetExpense(expense.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 25.0, updated.Amount)
+	assert.Empty(t, updated.Category)
+
+	deleted, err := db.DeleteExpense(expense.ID)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	missing, err := db.GetExpense(expense.ID)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestExpensesFollowProject(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	date := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	_, err := db.CreateManualEntry("old", "Work", date, date.Add(time.Hour), nil, nil, true)
+	require.NoError(t, err)
+	_, err = db.AddExpense(&Expense{ProjectName: "old", Date: date, Amount: 20, Currency: "USD"})
+	require.NoError(t, err)
+
+	result, err := db.MergeProjects("old", "new", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.ExpensesMoved)
+
+	expenses, err := db.FindExpenses(ExpenseFilter{Project: "new"})
+	require.NoError(t, err)
+	assert.Len(t, expenses, 1)
+
+	deleted, err := db.DeleteProject("new")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted.ExpensesDeleted)
+
+	expenses, err = db.FindExpenses(ExpenseFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, expenses)
+}
@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FXRate is an exchange rate: one unit of Base is worth Rate units of Quote
+// on Date.
+type FXRate struct {
+	ID    int64
+	Base  string
+	Quote string
+	Rate  float64
+	Date  time.Time
+}
+
+// SetFXRate stores an exchange rate, replacing any rate for the same currency
+// pair and date.
+func (d *Database) SetFXRate(base, quote string, rate float64, date time.Time) error {
+	return d.SetFXRates([]*FXRate{{Base: base, Quote: quote, Rate: rate, Date: date}})
+}
+
+// SetFXRates stores several exchange rates in a single transaction, replacing
+// rates for the same currency pair and date.
+func (d *Database) SetFXRates(rates []*FXRate) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, rate := range rates {
+		base := strings.ToUpper(strings.TrimSpace(rate.Base))
+		quote := strings.ToUpper(strings.TrimSpace(rate.Quote))
+		if base == quote {
+			return fmt.Errorf("can't set an exchange rate from %s to itself", base)
+		}
+		if rate.Rate <= 0 {
+			return fmt.Errorf("exchange rate for %s/%s must be greater than 0", base, quote)
+		}
+
+		_, err := tx.Exec(`
+			INSERT INTO fx_rates (base, quote, rate, date) VALUES (?, ?, ?, ?)
+			ON CONFLICT(base, quote, date) DO UPDATE SET rate = excluded.rate
+		`, base, quote, rate.Rate, rate.Date.UTC())
+		if err != nil {
+			return fmt.Errorf("failed to set exchange rate: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetFXRates returns the stored exchange rates, optionally only those of a
+// base or quote currency, sorted by pair and latest date first.
+func (d *Database) GetFXRates(base, quote string) ([]*FXRate, error) {
+	query := "SELECT id, base, quote, rate, date FROM fx_rates"
+	var conditions []string
+	var args []any
+
+	if base != "" {
+		conditions = append(conditions, "base = ?")
+		args = append(args, strings.ToUpper(strings.TrimSpace(base)))
+	}
+	if quote != "" {
+		conditions = append(conditions, "quote = ?")
+		args = append(args, strings.ToUpper(strings.TrimSpace(quote)))
+	}
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY base, quote, date DESC"
+
+	rows, err := d.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query exchange rates: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []*FXRate
+	for rows.Next() {
+		var rate FXRate
+		if err := rows.Scan(&rate.ID, &rate.Base, &rate.Quote, &rate.Rate, &rate.Date); err != nil {
+			return nil, fmt.Errorf("failed to scan exchange rate: %w", err)
+		}
+		rates = append(rates, &rate)
+	}
+
+	return rates, rows.Err()
+}
+
+// DeleteFXRate removes an exchange rate and reports whether it existed.
+func (d *Database) DeleteFXRate(id int64) (bool, error) {
+	result, err := d.db.Exec("DELETE FROM fx_rates WHERE id = ?", id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete exchange rate: %w", err)
+	}
+
+	deleted, _ := result.RowsAffected()
+
+	return deleted > 0, nil
+}
+
+// FXTable converts amounts between currencies using the stored exchange rates.
+type FXTable struct {
+	rates map[string][]*FXRate // keyed by "BASE/QUOTE", oldest first
+}
+
+// LoadFXTable loads every stored exchange rate for conversions.
+func (d *Database) LoadFXTable() (*FXTable, error) {
+	rates, err := d.GetFXRates("", "")
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFXTable(rates), nil
+}
+
+func NewFXTable(rates []*FXRate) *FXTable {
+	table := &FXTable{rates: make(map[string][]*FXRate)}
+	for _, rate := range rates {
+		key := rate.Base + "/" + rate.Quote
+		table.rates[key] = append(table.rates[key], rate)
+	}
+
+	for _, pair := range table.rates {
+		sort.Slice(pair, func(i, j int) bool {
+			return pair[i].Date.Before(pair[j].Date)
+		})
+	}
+
+	return table
+}
+
+// Convert converts amount from one currency to another at the exchange rate
+// in effect at the given time: the latest rate on or before it, or the
+// earliest rate when it predates them all. A rate stored for the reverse pair
+// is used inverted. ok is false when no rate exists for the pair.
+func (t *FXTable) Convert(amount float64, from, to string, at time.Time) (converted float64, ok bool) {
+	from = strings.ToUpper(strings.TrimSpace(from))
+	to = strings.ToUpper(strings.TrimSpace(to))
+
+	if from == to {
+		return amount, true
+	}
+
+	if rate := t.rateAt(from+"/"+to, at); rate != nil {
+		return amount * rate.Rate, true
+	}
+
+	if rate := t.rateAt(to+"/"+from, at); rate != nil {
+		return amount / rate.Rate, true
+	}
+
+	return 0, false
+}
+
+func (t *FXTable) rateAt(pair string, at time.Time) *FXRate {
+	rates := t.rates[pair]
+	if len(rates) == 0 {
+		return nil
+	}
+
+	match := rates[0]
+	for _, rate := range rates {
+		if rate.Date.After(at) {
+			break
+		}
+		match = rate
+	}
+
+	return match
+}
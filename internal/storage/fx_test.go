@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetFXRate(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, db.SetFXRate("eur", "usd", 1.08, jan))
+	require.NoError(t, db.SetFXRate("EUR", "USD", 1.10, feb))
+	require.NoError(t, db.SetFXRate("GBP", "USD", 1.27, jan))
+
+	// setting a rate for the same pair and date replaces it
+	require.NoError(t, db.SetFXRate("EUR", "USD", 1.09, jan))
+
+	rates, err := db.GetFXRates("", "")
+	require.NoError(t, err)
+	require.Len(t, rates, 3)
+	assert.Equal(t, "EUR", rates[0].Base)
+	assert.Equal(t, "USD", rates[0].Quote)
+	assert.Equal(t, 1.10, rates[0].Rate)
+	assert.Equal(t, 1.09, rates[1].Rate)
+	assert.True(t, rates[1].Date.Equal(jan))
+
+	eur, err := db.GetFXRates("EUR", "")
+	require.NoError(t, err)
+	assert.Len(t, eur, 2)
+
+	assert.Error(t, db.SetFXRate("USD", "USD", 1, jan))
+	assert.Error(t, db.SetFXRate("EUR", "USD", 0, jan))
+
+	// a failing rate rolls back the whole batch
+	err = db.SetFXRates([]*FXRate{
+		{Base: "CHF", Quote: "USD", Rate: 1.12, Date: jan},
+		{Base: "JPY", Quote: "USD", Rate: -1, Date: jan},
+	})
+	assert.Error(t, err)
+
+	chf, err := db.GetFXRates("CHF", "")
+	require.NoError(t, err)
+	assert.Empty(t, chf)
+
+	deleted, err := db.DeleteFXRate(rates[0].ID)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	deleted, err = db.DeleteFXRate(rates[0].ID)
+	require.NoError(t, err)
+	assert.False(t, deleted)
+}
+
+func TestFXTableConvert(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	table := NewFXTable([]*FXRate{
+		{Base: "EUR", Quote: "USD", Rate: 1.25, Date: feb},
+		{Base: "EUR", Quote: "USD", Rate: 1.10, Date: jan},
+	})
+
+	tests := []struct {
+		name     string
+		amount   float64
+		from     string
+		to       string
+		at       time.Time
+		expected float64
+		ok       bool
+	}{
+		{"same currency", 100, "usd", "USD", jan, 100, true},
+		{"rate on the day", 100, "EUR", "USD", jan, 110, true},
+		{"latest earlier rate", 100, "EUR", "USD", feb.AddDate(0, 0, 10), 125, true},
+		{"before every rate", 100, "EUR", "USD", jan.AddDate(0, 0, -1), 110, true},
+		{"inverse pair", 125, "USD", "EUR", feb, 100, true},
+		{"no rate", 100, "GBP", "USD", feb, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converted, ok := table.Convert(tt.amount, tt.from, tt.to, tt.at)
+			assert.Equal(t, tt.ok, ok)
+			assert.InDelta(t, tt.expected, converted, 0.0001)
+		})
+	}
+}
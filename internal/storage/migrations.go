@@ -10,6 +10,9 @@ import (
 // ! I'm adding this system so that future database migrations will be easier - Dylan
 const (
 	Migration001_UTCTimestamps = "001_utc_timestamps"
+	Migration002_MilestoneIDs  = "002_milestone_ids"
+	Migration003_SearchIndex   = "003_search_index"
+	Migration004_SearchNotes   = "004_search_notes"
 )
 
 // runMigrations executes all pending migrations
@@ -19,6 +22,21 @@ func (d *Database) runMigrations() error {
 		return fmt.Errorf("timestamp UTC migration failed: %w", err)
 	}
 
+	// Migration 2: Reference milestones by ID instead of name
+	if err := d.migrateMilestoneNamesToIDs(); err != nil {
+		return fmt.Errorf("milestone ID migration failed: %w", err)
+	}
+
+	// Migration 3: Index entries created before full-text search existed
+	if err := d.buildSearchIndex(); err != nil {
+		return fmt.Errorf("search index migration failed: %w", err)
+	}
+
+	// Migration 4: Add entry notes to the search index
+	if err := d.addNotesToSearchIndex(); err != nil {
+		return fmt.Errorf("search notes migration failed: %w", err)
+	}
+
 	return nil
 }
 
@@ -225,3 +243,101 @@ func (d *Database) migrateMilestonesTableToUTC(tx *sql.Tx) error {
 
 	return nil
 }
+
+// migrateMilestoneNamesToIDs fills milestone_id on entries that were tagged by
+// milestone name before entries referenced milestones by ID.
+func (d *Database) migrateMilestoneNamesToIDs() error {
+	completed, err := d.hasMigrationRun(Migration002_MilestoneIDs)
+	if err != nil {
+		return err
+	}
+
+	if completed {
+		return nil
+	}
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	_, err = tx.Exec(`
+		UPDATE time_entries
+		SET milestone_id = (
+			SELECT m.id FROM milestones m
+			WHERE m.project_name = time_entries.project_name AND m.name = time_entries.milestone_name
+		)
+		WHERE milestone_name IS NOT NULL AND milestone_id IS NULL
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to backfill milestone ids: %w", err)
+	}
+
+	_, err = tx.Exec(
+		"INSERT OR REPLACE INTO settings (key, value, updated_at) VALUES (?, ?, ?)",
+		Migration002_MilestoneIDs,
+		"completed",
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark migration complete: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit migration transaction: %w", err)
+	}
+
+	return nil
+}
+
+// buildSearchIndex fills the full-text search index from existing entries.
+// The index's triggers keep it up to date from then on.
+func (d *Database) buildSearchIndex() error {
+	completed, err := d.hasMigrationRun(Migration003_SearchIndex)
+	if err != nil {
+		return err
+	}
+
+	if completed {
+		return nil
+	}
+
+	if _, err := d.db.Exec("INSERT INTO entries_search(entries_search) VALUES ('rebuild')"); err != nil {
+		return fmt.Errorf("failed to build search index: %w", err)
+	}
+
+	return d.markMigrationComplete(Migration003_SearchIndex)
+}
+
+// addNotesToSearchIndex recreates a search index built before entries had
+// notes, since FTS5 tables can't have columns added.
+func (d *Database) addNotesToSearchIndex() error {
+	completed, err := d.hasMigrationRun(Migration004_SearchNotes)
+	if err != nil {
+		return err
+	}
+
+	if completed {
+		return nil
+	}
+
+	if err := dropSearchIndex(d.db); err != nil {
+		return err
+	}
+
+	if err := createSearchIndex(d.db); err != nil {
+		return err
+	}
+
+	if _, err := d.db.Exec("INSERT INTO entries_search(entries_search) VALUES ('rebuild')"); err != nil {
+		return fmt.Errorf("failed to build search index: %w", err)
+	}
+
+	return d.markMigrationComplete(Migration004_SearchNotes)
+}
@@ -33,7 +33,12 @@ func setupMigrationTestDB(t *testing.T) *Database {
 			end_time DATETIME,
 			description TEXT,
 			hourly_rate REAL,
-			milestone_name TEXT
+			milestone_name TEXT,
+			milestone_id INTEGER,
+			tags TEXT,
+			issue_key TEXT,
+			notes TEXT,
+			billable INTEGER NOT NULL DEFAULT 1
 		)
 	`)
 	assert.NoError(t, err)
@@ -46,11 +51,14 @@ func setupMigrationTestDB(t *testing.T) *Database {
 			name TEXT NOT NULL,
 			start_time DATETIME NOT NULL,
 			end_time DATETIME,
+			activated_at DATETIME,
 			UNIQUE(project_name, name)
 		)
 	`)
 	assert.NoError(t, err)
 
+	assert.NoError(t, createSearchIndex(db))
+
 	return &Database{db: db}
 }
 
@@ -162,7 +170,7 @@ func TestMigrateTimestampsToUTC_LocalToUTC(t *testing.T) {
 	assert.NoError(t, err)
 
 	localTime := time.Date(2026, 1, 8, 15, 30, 0, 0, est) // 3:30 PM EST
-	expectedUTC := localTime.UTC()                          // Should convert to 8:30 PM UTC
+	expectedUTC := localTime.UTC()                        // Should convert to 8:30 PM UTC
 
 	// Insert time entry with local timezone
 	_, err = db.db.Exec(
@@ -452,3 +460,125 @@ func TestMigrateMilestonesTableToUTC_EmptyTable(t *testing.T) {
 	err = db.migrateMilestonesTableToUTC(tx)
 	assert.NoError(t, err)
 }
+
+func TestMigrateMilestoneNamesToIDs(t *testing.T) {
+	db := setupMigrationTestDB(t)
+	defer db.Close()
+
+	result, err := db.db.Exec(
+		"INSERT INTO milestones (project_name, name, start_time) VALUES (?, ?, ?)",
+		"test-project",
+		"Sprint 1",
+		time.Now().UTC(),
+	)
+	assert.NoError(t, err)
+	milestoneID, err := result.LastInsertId()
+	assert.NoError(t, err)
+
+	// legacy entry tagged by name only
+	_, err = db.db.Exec(
+		"INSERT INTO time_entries (project_name, start_time, description, milestone_name) VALUES (?, ?, ?, ?)",
+		"test-project",
+		time.Now().UTC(),
+		"Tagged entry",
+		"Sprint 1",
+	)
+	assert.NoError(t, err)
+
+	// same milestone name on another project must not match
+	_, err = db.db.Exec(
+		"INSERT INTO time_entries (project_name, start_time, description, milestone_name) VALUES (?, ?, ?, ?)",
+		"other-project",
+		time.Now().UTC(),
+		"Other entry",
+		"Sprint 1",
+	)
+	assert.NoError(t, err)
+
+	err = db.migrateMilestoneNamesToIDs()
+	assert.NoError(t, err)
+
+	var linked sql.NullInt64
+	err = db.db.QueryRow("SELECT milestone_id FROM time_entries WHERE id = 1").Scan(&linked)
+	assert.NoError(t, err)
+	assert.True(t, linked.Valid)
+	assert.Equal(t, milestoneID, linked.Int64)
+
+	var unlinked sql.NullInt64
+	err = db.db.QueryRow("SELECT milestone_id FROM time_entries WHERE id = 2").Scan(&unlinked)
+	assert.NoError(t, err)
+	assert.False(t, unlinked.Valid)
+
+	hasRun, err := db.hasMigrationRun(Migration002_MilestoneIDs)
+	assert.NoError(t, err)
+	assert.True(t, hasRun)
+}
+
+func TestBuildSearchIndex(t *testing.T) {
+	db := setupMigrationTestDB(t)
+	defer db.Close()
+
+	_, err := db.db.Exec(
+		"INSERT INTO time_entries (project_name, start_time, description) VALUES (?, ?, ?)",
+		"test-project",
+		time.Now().UTC(),
+		"Fix OAuth callback",
+	)
+	assert.NoError(t, err)
+
+	// entries created before the index existed aren't in it
+	_, err = db.db.Exec("INSERT INTO entries_search(entries_search) VALUES ('delete-all')")
+	assert.NoError(t, err)
+
+	results, err := db.SearchEntries("oauth", EntryFilter{}, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+
+	err = db.buildSearchIndex()
+	assert.NoError(t, err)
+
+	results, err = db.SearchEntries("oauth", EntryFilter{}, 0)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+
+	hasRun, err := db.hasMigrationRun(Migration003_SearchIndex)
+	assert.NoError(t, err)
+	assert.True(t, hasRun)
+}
+
+func TestAddNotesToSearchIndex(t *testing.T) {
+	db := setupMigrationTestDB(t)
+	defer db.Close()
+
+	// an index built before entries had notes
+	assert.NoError(t, dropSearchIndex(db.db))
+	_, err := db.db.Exec(`
+		CREATE VIRTUAL TABLE entries_search USING fts5(
+			description,
+			tags,
+			content='time_entries',
+			content_rowid='id'
+		)
+	`)
+	assert.NoError(t, err)
+
+	_, err = db.db.Exec(
+		"INSERT INTO time_entries (project_name, start_time, description, notes) VALUES (?, ?, ?, ?)",
+		"test-project",
+		time.Now().UTC(),
+		"Standup",
+		"Discussed the OAuth rollout",
+	)
+	assert.NoError(t, err)
+
+	err = db.addNotesToSearchIndex()
+	assert.NoError(t, err)
+
+	results, err := db.SearchEntries("oauth", EntryFilter{}, 0)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+
+	hasRun, err := db.hasMigrationRun(Migration004_SearchNotes)
+	assert.NoError(t, err)
+	assert.True(t, hasRun)
+}
@@ -1,22 +1,30 @@
 package storage
 
 import (
+	"database/sql"
 	"math"
+	"strings"
 	"time"
 )
 
 type TimeEntry struct {
-	ID int64
-	ProjectName string
-	StartTime time.Time
-	EndTime *time.Time
-	Description string
-	HourlyRate *float64
+	ID            int64
+	ProjectName   string
+	StartTime     time.Time
+	EndTime       *time.Time
+	Description   string
+	HourlyRate    *float64
+	MilestoneID   *int64
 	MilestoneName *string
+	Tags          []string
+	IssueKey      string
+	Notes         string
+	Billable      bool
+	Commits       []*EntryCommit
 }
 
 func (t *TimeEntry) Duration() time.Duration {
-	if( t.EndTime == nil) {
+	if t.EndTime == nil {
 		return time.Since(t.StartTime)
 	}
 
@@ -27,12 +35,58 @@ func (t *TimeEntry) IsRunning() bool {
 	return t.EndTime == nil
 }
 
+// HasTag reports whether the entry carries the given tag (case-insensitive).
+func (t *TimeEntry) HasTag(tag string) bool {
+	for _, existing := range t.Tags {
+		if strings.EqualFold(existing, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // RoundedHours returns duration in hours rounded to 2 decimal places for billing.
 // Could be made configurable to support different rounding increments (0.1h, 0.25h, etc).
 func (t *TimeEntry) RoundedHours() float64 {
 	return math.Round(t.Duration().Hours()*100) / 100
 }
 
+// IsBilled reports whether the entry earns money, i.e. it is billable and
+// has an hourly rate.
+func (t *TimeEntry) IsBilled() bool {
+	return t.Billable && t.HourlyRate != nil
+}
+
+// Earnings returns the entry's rounded hours at its hourly rate, or 0 when
+// the entry isn't billed.
+func (t *TimeEntry) Earnings() float64 {
+	if !t.IsBilled() {
+		return 0
+	}
+
+	return t.RoundedHours() * *t.HourlyRate
+}
+
+// EntryCommit is a git commit recorded against the entry that was running
+// when it was made.
+type EntryCommit struct {
+	ID          int64
+	EntryID     int64
+	SHA         string
+	Message     string
+	CommittedAt time.Time
+}
+
+// ShortSHA returns the abbreviated commit hash used in listings.
+func (c *EntryCommit) ShortSHA() string {
+	if len(c.SHA) > 7 {
+		return c.SHA[:7]
+	}
+
+	return c.SHA
+}
+
 type Milestone struct {
 	ID          int64
 	ProjectName string
@@ -50,4 +104,46 @@ func (m *Milestone) Duration() time.Duration {
 		return time.Since(m.StartTime)
 	}
 	return m.EndTime.Sub(m.StartTime)
-}
\ No newline at end of file
+}
+
+// NormalizeTags trims, drops empty values and removes case-insensitive
+// duplicates while keeping the original order.
+func NormalizeTags(tags []string) []string {
+	var normalized []string
+	seen := make(map[string]bool)
+
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
+}
+
+// tags are stored as a comma separated list in the tags column
+func splitTags(value string) []string {
+	return NormalizeTags(strings.Split(value, ","))
+}
+
+func joinTags(tags []string) sql.NullString {
+	tags = NormalizeTags(tags)
+	if len(tags) == 0 {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: strings.Join(tags, ","), Valid: true}
+}
+
+func nullString(value string) sql.NullString {
+	if value == "" {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: value, Valid: true}
+}
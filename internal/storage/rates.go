@@ -0,0 +1,219 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Rate is an hourly rate that applies to a project's entries from
+// EffectiveFrom on. A rate with a milestone or tag only applies to entries of
+// that milestone or with that tag, and overrides the project's schedule.
+type Rate struct {
+	ID            int64
+	ProjectName   string
+	MilestoneID   *int64
+	MilestoneName *string
+	Tag           string
+	HourlyRate    float64
+	EffectiveFrom time.Time
+}
+
+// Scope describes what the rate applies to.
+func (r *Rate) Scope() string {
+	if r.MilestoneName != nil {
+		return "milestone " + *r.MilestoneName
+	}
+	if r.Tag != "" {
+		return "tag " + r.Tag
+	}
+
+	return "project"
+}
+
+// AddRate schedules an hourly rate for a project. milestoneID and tag are
+// optional and mutually exclusive.
+func (d *Database) AddRate(projectName string, hourlyRate float64, effectiveFrom time.Time, milestoneID *int64, tag string) (*Rate, error) {
+	if milestoneID != nil && tag != "" {
+		return nil, fmt.Errorf("a rate can't apply to both a milestone and a tag")
+	}
+
+	result, err := d.db.Exec(
+		"INSERT INTO rates (project_name, milestone_id, tag, hourly_rate, effective_from) VALUES (?, ?, ?, ?, ?)",
+		projectName,
+		milestoneID,
+		nullString(strings.TrimSpace(tag)),
+		hourlyRate,
+		effectiveFrom.UTC(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add rate: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return d.GetRate(id)
+}
+
+const rateColumns = `r.id, r.project_name, r.milestone_id, m.name, r.tag, r.hourly_rate, r.effective_from
+	FROM rates r
+	LEFT JOIN milestones m ON r.milestone_id = m.id`
+
+func scanRate(row rowScanner) (*Rate, error) {
+	var rate Rate
+	var milestoneID sql.NullInt64
+	var milestoneName, tag sql.NullString
+
+	err := row.Scan(&rate.ID, &rate.ProjectName, &milestoneID, &milestoneName, &tag, &rate.HourlyRate, &rate.EffectiveFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	if milestoneID.Valid {
+		rate.MilestoneID = &milestoneID.Int64
+	}
+	if milestoneName.Valid {
+		rate.MilestoneName = &milestoneName.String
+	}
+	rate.Tag = tag.String
+
+	return &rate, nil
+}
+
+func (d *Database) GetRate(id int64) (*Rate, error) {
+	rate, err := scanRate(d.db.QueryRow("SELECT "+rateColumns+" WHERE r.id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rate: %w", err)
+	}
+
+	return rate, nil
+}
+
+// GetRates returns the rates of a project, latest effective date first. An
+// empty project name returns the rates of every project.
+func (d *Database) GetRates(projectName string) ([]*Rate, error) {
+	query := "SELECT " + rateColumns
+	var args []any
+	if projectName != "" {
+		query += " WHERE r.project_name = ?"
+		args = append(args, projectName)
+	}
+	query += " ORDER BY r.project_name, r.effective_from DESC, r.id DESC"
+
+	rows, err := d.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query rates: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []*Rate
+	for rows.Next() {
+		rate, err := scanRate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan rate: %w", err)
+		}
+		rates = append(rates, rate)
+	}
+
+	return rates, rows.Err()
+}
+
+func (d *Database) DeleteRate(id int64) error {
+	_, err := d.db.Exec("DELETE FROM rates WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("failed to delete rate: %w", err)
+	}
+
+	return nil
+}
+
+// MatchRate picks the rate that applies to an entry of a milestone with the
+// given tags started at the given time. rates must be the project's rates,
+// latest first as returned by GetRates. Among the rates in effect, a milestone
+// rate beats a tag rate, which beats the project's rate. It returns nil when
+// no rate is in effect.
+func MatchRate(rates []*Rate, milestoneID *int64, tags []string, at time.Time) *Rate {
+	var tagRate, projectRate *Rate
+
+	for _, rate := range rates {
+		if rate.EffectiveFrom.After(at) {
+			continue
+		}
+
+		switch {
+		case rate.MilestoneID != nil:
+			if milestoneID != nil && *rate.MilestoneID == *milestoneID {
+				return rate
+			}
+		case rate.Tag != "":
+			if tagRate == nil && hasTag(tags, rate.Tag) {
+				tagRate = rate
+			}
+		default:
+			if projectRate == nil {
+				projectRate = rate
+			}
+		}
+	}
+
+	if tagRate != nil {
+		return tagRate
+	}
+
+	return projectRate
+}
+
+// ResolveRate returns the scheduled hourly rate for a new entry, or nil when
+// the project has no rate in effect and the configured rate should be used.
+func (d *Database) ResolveRate(projectName string, milestoneID *int64, tags []string, at time.Time) (*float64, error) {
+	rates, err := d.GetRates(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	rate := MatchRate(rates, milestoneID, tags, at)
+	if rate == nil {
+		return nil, nil
+	}
+
+	return &rate.HourlyRate, nil
+}
+
+// SetEntryRates updates the hourly rate of several entries in a single
+// transaction. A nil rate clears the entry's rate.
+func (d *Database) SetEntryRates(rates map[int64]*float64) error {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for id, rate := range rates {
+		if _, err := tx.Exec("UPDATE time_entries SET hourly_rate = ? WHERE id = ?", rate, id); err != nil {
+			return fmt.Errorf("failed to set entry rate: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+
+	return false
+}
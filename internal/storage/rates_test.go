@@ -0,0 +1,204 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddRate(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	milestone, err := db.CreateMilestone("web", "Sprint 1")
+	require.NoError(t, err)
+
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err = db.AddRate("web", 100, jan, nil, "")
+	require.NoError(t, err)
+	_, err = db.AddRate("web", 120, mar, nil, "")
+	require.NoError(t, err)
+	sprint, err := db.AddRate("web", 150, jan, &milestone.ID, "")
+	require.NoError(t, err)
+	support, err := db.AddRate("web", 80, jan, nil, " support ")
+	require.NoError(t, err)
+	_, err = db.AddRate("api", 90, jan, nil, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "milestone Sprint 1", sprint.Scope())
+	assert.Equal(t, "support", support.Tag)
+	assert.Equal(t, "tag support", support.Scope())
+
+	_, err = db.AddRate("web", 90, jan, &milestone.ID, "support")
+	assert.Error(t, err)
+
+	rates, err := db.GetRates("web")
+	require.NoError(t, err)
+	require.Len(t, rates, 4)
+	assert.Equal(t, 120.0, rates[0].HourlyRate)
+	assert.True(t, rates[0].EffectiveFrom.Equal(mar))
+	assert.Equal(t, "project", rates[0].Scope())
+
+	all, err := db.GetRates("")
+	require.NoError(t, err)
+	assert.Len(t, all, 5)
+
+	require.NoError(t, db.DeleteRate(support.ID))
+
+	deleted, err := db.GetRate(support.ID)
+	require.NoError(t, err)
+	assert.Nil(t, deleted)
+}
+
+func TestResolveRate(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	milestone, err := db.CreateMilestone("web", "Sprint 1")
+	require.NoError(t, err)
+
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err = db.AddRate("web", 100, jan, nil, "")
+	require.NoError(t, err)
+	_, err = db.AddRate("web", 120, mar, nil, "")
+	require.NoError(t, err)
+	_, err = db.AddRate("web", 80, mar, nil, "support")
+	require.NoError(t, err)
+	_, err = db.AddRate("web", 150, mar, &milestone.ID, "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		project     string
+		milestoneID *int64
+		tags        []string
+		at          time.Time
+		expected    *float64
+	}{
+		{"before any rate", "web", nil, nil, jan.Add(-time.Hour), nil},
+		{"first project rate", "web", nil, nil, jan.AddDate(0, 1, 0), floatPtr(100)},
+		{"rate change", "web", nil, nil, mar, floatPtr(120)},
+		{"tag override", "web", nil, []string{"Support"}, mar, floatPtr(80)},
+		{"tag override not yet in effect", "web", nil, []string{"support"}, jan, floatPtr(100)},
+		{"milestone beats tag", "web", &milestone.ID, []string{"support"}, mar, floatPtr(150)},
+		{"other project", "api", nil, nil, mar, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, err := db.ResolveRate(tt.project, tt.milestoneID, tt.tags, tt.at)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rate)
+		})
+	}
+}
+
+func TestSetEntryRates(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	rate := 100.0
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	first, err := db.CreateManualEntry("web", "First", start, start.Add(time.Hour), &rate, nil, true)
+	require.NoError(t, err)
+	second, err := db.CreateManualEntry("web", "Second", start.Add(2*time.Hour), start.Add(3*time.Hour), nil, nil, true)
+	require.NoError(t, err)
+
+	require.NoError(t, db.SetEntryRates(map[int64]*float64{
+		first.ID:  nil,
+		second.ID: floatPtr(120),
+	}))
+
+	updated, err := db.GetEntry(first.ID)
+	require.NoError(t, err)
+	assert.Nil(t, updated.HourlyRate)
+
+	updated, err = db.GetEntry(second.ID)
+	require.NoError(t, err)
+	require.NotNil(t, updated.HourlyRate)
+	assert.Equal(t, 120.0, *updated.HourlyRate)
+}
+
+func TestProjectRatesFollowProject(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	_, err := db.CreateManualEntry("old", "Work", start, start.Add(time.Hour), nil, nil, true)
+	require.NoError(t, err)
+	_, err = db.CreateManualEntry("target", "Work", start, start.Add(time.Hour), nil, nil, true)
+	require.NoError(t, err)
+
+	_, err = db.AddRate("old", 100, start, nil, "")
+	require.NoError(t, err)
+	_, err = db.AddRate("target", 120, start, nil, "")
+	require.NoError(t, err)
+
+	// renaming keeps the schedule
+	result, err := db.MergeProjects("old", "new", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.RatesMoved)
+
+	rates, err := db.GetRates("new")
+	require.NoError(t, err)
+	require.Len(t, rates, 1)
+	assert.Equal(t, 100.0, rates[0].HourlyRate)
+
+	// merging keeps the target's schedule
+	result, err = db.MergeProjects("new", "target", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.RatesDropped)
+
+	rates, err = db.GetRates("")
+	require.NoError(t, err)
+	require.Len(t, rates, 1)
+	assert.Equal(t, "target", rates[0].ProjectName)
+	assert.Equal(t, 120.0, rates[0].HourlyRate)
+
+	_, err = db.DeleteProject("target")
+	require.NoError(t, err)
+
+	rates, err = db.GetRates("")
+	require.NoError(t, err)
+	assert.Empty(t, rates)
+}
+
+func TestMergeProjectsMovesRatesToTargetWithoutSchedule(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	sourceSprint, err := db.CreateMilestone("api", "Sprint 1")
+	require.NoError(t, err)
+	targetSprint, err := db.CreateMilestone("api-server", "Sprint 1")
+	require.NoError(t, err)
+	_, err = db.CreateManualEntry("api-server", "Work", start, start.Add(time.Hour), nil, nil, true)
+	require.NoError(t, err)
+
+	_, err = db.AddRate("api", 100, start, nil, "")
+	require.NoError(t, err)
+	_, err = db.AddRate("api", 150, start, &sourceSprint.ID, "")
+	require.NoError(t, err)
+
+	result, err := db.MergeProjects("api", "api-server", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), result.RatesMoved)
+	assert.Zero(t, result.RatesDropped)
+
+	rates, err := db.GetRates("api-server")
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+
+	// the milestone rate follows the merged milestone
+	rate, err := db.ResolveRate("api-server", &targetSprint.ID, nil, start)
+	require.NoError(t, err)
+	require.NotNil(t, rate)
+	assert.Equal(t, 150.0, *rate)
+}
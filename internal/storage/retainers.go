@@ -0,0 +1,116 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RetainerTopUp is a block of hours a client paid for in advance. Billable
+// time tracked on the project from the first top-up on draws it down.
+type RetainerTopUp struct {
+	ID          int64
+	ProjectName string
+	Date        time.Time
+	Hours       float64
+	Note        string
+}
+
+// AddRetainerTopUp adds prepaid hours to a project's retainer.
+func (d *Database) AddRetainerTopUp(projectName string, hours float64, date time.Time, note string) (*RetainerTopUp, error) {
+	if strings.TrimSpace(projectName) == "" {
+		return nil, fmt.Errorf("a retainer needs a project")
+	}
+	if hours <= 0 {
+		return nil, fmt.Errorf("retainer hours must be greater than 0")
+	}
+
+	result, err := d.db.Exec(
+		"INSERT INTO retainers (project_name, date, hours, note) VALUES (?, ?, ?, ?)",
+		projectName,
+		date.UTC(),
+		hours,
+		nullString(strings.TrimSpace(note)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add retainer hours: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last insert id: %w", err)
+	}
+
+	return d.GetRetainerTopUp(id)
+}
+
+const retainerColumns = "id, project_name, date, hours, note FROM retainers"
+
+func scanRetainerTopUp(row rowScanner) (*RetainerTopUp, error) {
+	var topUp RetainerTopUp
+	var note sql.NullString
+
+	err := row.Scan(&topUp.ID, &topUp.ProjectName, &topUp.Date, &topUp.Hours, &note)
+	if err != nil {
+		return nil, err
+	}
+
+	topUp.Note = note.String
+
+	return &topUp, nil
+}
+
+// GetRetainerTopUp returns a top-up, or nil when it doesn't exist.
+func (d *Database) GetRetainerTopUp(id int64) (*RetainerTopUp, error) {
+	topUp, err := scanRetainerTopUp(d.db.QueryRow("SELECT "+retainerColumns+" WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get retainer top-up: %w", err)
+	}
+
+	return topUp, nil
+}
+
+// GetRetainerTopUps returns the top-ups of a project, oldest first. An empty
+// project name returns the top-ups of every project.
+func (d *Database) GetRetainerTopUps(projectName string) ([]*RetainerTopUp, error) {
+	query := "SELECT " + retainerColumns
+	var args []any
+	if projectName != "" {
+		query += " WHERE project_name = ?"
+		args = append(args, projectName)
+	}
+	query += " ORDER BY project_name, date, id"
+
+	rows, err := d.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query retainers: %w", err)
+	}
+	defer rows.Close()
+
+	var topUps []*RetainerTopUp
+	for rows.Next() {
+		topUp, err := scanRetainerTopUp(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan retainer top-up: %w", err)
+		}
+		topUps = append(topUps, topUp)
+	}
+
+	return topUps, rows.Err()
+}
+
+// DeleteRetainerTopUp removes a top-up and reports whether it existed.
+func (d *Database) DeleteRetainerTopUp(id int64) (bool, error) {
+	result, err := d.db.Exec("DELETE FROM retainers WHERE id = ?", id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete retainer top-up: %w", err)
+	}
+
+	deleted, _ := result.RowsAffected()
+
+	return deleted > 0, nil
+}
@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetainerTopUps(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	jan := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)
+
+	second, err := db.AddRetainerTopUp("web", 10, feb, " Invoice 2024-02 ")
+	require.NoError(t, err)
+	assert.Equal(t, "Invoice 2024-02", second.Note)
+
+	first, err := db.AddRetainerTopUp("web", 20, jan, "")
+	require.NoError(t, err)
+	_, err = db.AddRetainerTopUp("api", 5, jan, "")
+	require.NoError(t, err)
+
+	_, err = db.AddRetainerTopUp("web", 0, jan, "")
+	assert.Error(t, err)
+
+	web, err := db.GetRetainerTopUps("web")
+	require.NoError(t, err)
+	require.Len(t, web, 2)
+	assert.Equal(t, first.ID, web[0].ID)
+	assert.True(t, web[0].Date.Equal(jan))
+	assert.Equal(t, 20.0, web[0].Hours)
+
+	all, err := db.GetRetainerTopUps("")
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+
+	deleted, err := db.DeleteRetainerTopUp(first.ID)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	missing, err := db.GetRetainerTopUp(first.ID)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestRetainerTopUpsFollowProject(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	date := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	_, err := db.AddRetainerTopUp("old", 20, date, "")
+	require.NoError(t, err)
+
+	result, err := db.MergeProjects("old", "new", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.TopUpsMoved)
+
+	deleted, err := db.DeleteProject("new")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted.TopUpsDeleted)
+
+	topUps, err := db.GetRetainerTopUps("")
+	require.NoError(t, err)
+	assert.Empty(t, topUps)
+}
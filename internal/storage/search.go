@@ -0,0 +1,218 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// Markers wrapped around matched terms in SearchResult highlights. They are
+// control characters so they can't clash with anything typed in an entry.
+const (
+	HighlightStart = "\x02"
+	HighlightEnd   = "\x03"
+)
+
+// searchSchema is an FTS5 index over entry descriptions, tags and notes. It
+// reads its content from time_entries and is kept in sync by triggers.
+const searchSchema = `
+	CREATE VIRTUAL TABLE IF NOT EXISTS entries_search USING fts5(
+		description,
+		tags,
+		notes,
+		content='time_entries',
+		content_rowid='id',
+		tokenize='unicode61 remove_diacritics 2'
+	);
+
+	CREATE TRIGGER IF NOT EXISTS entries_search_insert AFTER INSERT ON time_entries BEGIN
+		INSERT INTO entries_search(rowid, description, tags, notes) VALUES (new.id, new.description, new.tags, new.notes);
+	END;
+
+	CREATE TRIGGER IF NOT EXISTS entries_search_delete AFTER DELETE ON time_entries BEGIN
+		INSERT INTO entries_search(entries_search, rowid, description, tags, notes) VALUES ('delete', old.id, old.description, old.tags, old.notes);
+	END;
+
+	CREATE TRIGGER IF NOT EXISTS entries_search_update AFTER UPDATE OF description, tags, notes ON time_entries BEGIN
+		INSERT INTO entries_search(entries_search, rowid, description, tags, notes) VALUES ('delete', old.id, old.description, old.tags, old.notes);
+		INSERT INTO entries_search(rowid, description, tags, notes) VALUES (new.id, new.description, new.tags, new.notes);
+	END;
+`
+
+func createSearchIndex(db *sql.DB) error {
+	if _, err := db.Exec(searchSchema); err != nil {
+		return fmt.Errorf("failed to create search index: %w", err)
+	}
+
+	return nil
+}
+
+// dropSearchIndex removes the search index and its triggers so it can be
+// recreated with different columns.
+func dropSearchIndex(db *sql.DB) error {
+	_, err := db.Exec(`
+		DROP TRIGGER IF EXISTS entries_search_insert;
+		DROP TRIGGER IF EXISTS entries_search_delete;
+		DROP TRIGGER IF EXISTS entries_search_update;
+		DROP TABLE IF EXISTS entries_search;
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to drop search index: %w", err)
+	}
+
+	return nil
+}
+
+// SearchResult is an entry matching a search. Description, Tags and Notes
+// hold the entry's text with matches wrapped in HighlightStart and
+// HighlightEnd.
+type SearchResult struct {
+	Entry       *TimeEntry
+	Score       float64 // higher is a better match
+	Description string
+	Tags        string
+	Notes       string
+}
+
+// SearchEntries runs a full-text search over entry descriptions, tags and notes,
+// narrowed down by filter, and returns up to limit results (0 for all), best
+// matches first.
+func (d *Database) SearchEntries(query string, filter EntryFilter, limit int) ([]*SearchResult, error) {
+	match := searchExpression(query)
+	if match == "" {
+		return nil, fmt.Errorf("search query cannot be empty")
+	}
+
+	conditions, args := filter.conditions()
+	if filter.Tag != "" {
+		condition, arg := filter.tagCondition()
+		conditions = append(conditions, condition)
+		args = append(args, arg)
+	}
+	conditions = append([]string{"entries_search MATCH ?"}, conditions...)
+	args = append([]any{HighlightStart, HighlightEnd, HighlightStart, HighlightEnd, HighlightStart, HighlightEnd, match}, args...)
+
+	// descriptions weigh more than tags and notes when ranking
+	sqlQuery := `
+		SELECT ` + entryColumns + `,
+			bm25(entries_search, 2.0, 1.0, 1.0) AS rank,
+			highlight(entries_search, 0, ?, ?),
+			highlight(entries_search, 1, ?, ?),
+			highlight(entries_search, 2, ?, ?)
+		FROM entries_search
+		JOIN time_entries e ON e.id = entries_search.rowid
+		LEFT JOIN milestones m ON m.id = e.milestone_id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY rank, e.start_time DESC
+	`
+
+	if limit > 0 {
+		sqlQuery += fmt.Sprintf(" LIMIT %d", limit)
+	}
+
+	rows, err := d.db.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search entries: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*SearchResult
+	for rows.Next() {
+		var rank float64
+		var description, tags, notes sql.NullString
+
+		entry, err := scanEntry(rows, &rank, &description, &tags, &notes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan entry: %w", err)
+		}
+
+		results = append(results, &SearchResult{
+			Entry:       entry,
+			Score:       -rank,
+			Description: description.String,
+			Tags:        tags.String,
+			Notes:       notes.String,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search entries: %w", err)
+	}
+
+	return results, nil
+}
+
+// searchExpression turns what the user typed into an FTS5 query. Every word
+// must match, as a prefix so "auth" finds "authentication"; "quoted text" is
+// matched as a phrase, and a leading - excludes a word or phrase.
+func searchExpression(query string) string {
+	var include, exclude []string
+
+	for _, term := range splitSearchTerms(query) {
+		text, negated := term.text, false
+		if len(text) > 1 && strings.HasPrefix(text, "-") {
+			text, negated = text[1:], true
+		}
+
+		expr := `"` + strings.ReplaceAll(text, `"`, `""`) + `"`
+		if !term.phrase {
+			expr += "*"
+		}
+
+		if negated {
+			exclude = append(exclude, expr)
+		} else {
+			include = append(include, expr)
+		}
+	}
+
+	// FTS5 needs something to match before NOT
+	if len(include) == 0 {
+		return ""
+	}
+
+	expression := strings.Join(include, " ")
+	for _, expr := range exclude {
+		expression += " NOT " + expr
+	}
+
+	return expression
+}
+
+type searchTerm struct {
+	text   string
+	phrase bool
+}
+
+func splitSearchTerms(query string) []searchTerm {
+	var terms []searchTerm
+	var current strings.Builder
+	inQuotes, quoted := false, false
+
+	flush := func() {
+		if text := strings.TrimSpace(current.String()); text != "" && text != "-" {
+			terms = append(terms, searchTerm{text: text, phrase: quoted})
+		}
+		current.Reset()
+		quoted = false
+	}
+
+	for _, r := range query {
+		switch {
+		case r == '"':
+			if inQuotes {
+				flush()
+			} else {
+				quoted = true
+			}
+			inQuotes = !inQuotes
+		case !inQuotes && (r == ' ' || r == '\t' || r == '\n'):
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+
+	return terms
+}
@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchEntries(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	base := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+	create := func(project, description string, day int, tags ...string) *TimeEntry {
+		start := base.AddDate(0, 0, day)
+		entry, err := db.CreateManualEntry(project, description, start, start.Add(time.Hour), nil, nil, true)
+		require.NoError(t, err)
+		if len(tags) > 0 {
+			require.NoError(t, db.SetEntryTags(entry.ID, tags))
+		}
+		return entry
+	}
+
+	bug := create("web", "Fix OAuth bug in the login flow", 0)
+	tagged := create("web", "Pair on callback handling", 1, "oauth")
+	other := create("api", "OAuth token refresh", 2)
+	create("web", "Write release notes", 3)
+	noted := create("web", "Standup", 4)
+	require.NoError(t, db.SetEntryNotes(noted.ID, "Discussed the OAuth rollout"))
+
+	t.Run("ranks and highlights matches", func(t *testing.T) {
+		results, err := db.SearchEntries("oauth", EntryFilter{}, 0)
+		require.NoError(t, err)
+		require.Len(t, results, 4)
+
+		// description matches outrank tag and notes matches
+		assert.NotContains(t, []int64{tagged.ID, noted.ID}, results[0].Entry.ID)
+		assert.NotContains(t, []int64{tagged.ID, noted.ID}, results[1].Entry.ID)
+
+		for _, result := range results {
+			switch result.Entry.ID {
+			case tagged.ID:
+				assert.Equal(t, HighlightStart+"oauth"+HighlightEnd, result.Tags)
+			case noted.ID:
+				assert.Equal(t, "Discussed the "+HighlightStart+"OAuth"+HighlightEnd+" rollout", result.Notes)
+			}
+		}
+
+		for _, result := range results {
+			if result.Entry.ID == bug.ID {
+				assert.Equal(t, "Fix "+HighlightStart+"OAuth"+HighlightEnd+" bug in the login flow", result.Description)
+			}
+		}
+	})
+
+	t.Run("matches every word as a prefix", func(t *testing.T) {
+		results, err := db.SearchEntries("oaut bug", EntryFilter{}, 0)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, bug.ID, results[0].Entry.ID)
+	})
+
+	t.Run("supports phrases and exclusions", func(t *testing.T) {
+		results, err := db.SearchEntries(`"token refresh"`, EntryFilter{}, 0)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, other.ID, results[0].Entry.ID)
+
+		results, err = db.SearchEntries("oauth -bug -token -rollout", EntryFilter{}, 0)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, tagged.ID, results[0].Entry.ID)
+	})
+
+	t.Run("applies filters and limit", func(t *testing.T) {
+		results, err := db.SearchEntries("oauth", EntryFilter{Project: "api"}, 0)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, other.ID, results[0].Entry.ID)
+
+		start, end := base.AddDate(0, 0, 1), base.AddDate(0, 0, 3)
+		results, err = db.SearchEntries("oauth", EntryFilter{Start: &start, End: &end}, 1)
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("follows updates and deletes", func(t *testing.T) {
+		edited := *bug
+		edited.Description = "Fix SSO bug"
+		require.NoError(t, db.UpdateTimeEntry(bug.ID, &edited))
+		require.NoError(t, db.DeleteTimeEntry(other.ID))
+
+		require.NoError(t, db.SetEntryNotes(noted.ID, ""))
+
+		results, err := db.SearchEntries("oauth", EntryFilter{}, 0)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, tagged.ID, results[0].Entry.ID)
+
+		results, err = db.SearchEntries("sso", EntryFilter{}, 0)
+		require.NoError(t, err)
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("rejects empty queries", func(t *testing.T) {
+		_, err := db.SearchEntries("  ", EntryFilter{}, 0)
+		assert.Error(t, err)
+
+		_, err = db.SearchEntries("-bug", EntryFilter{}, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestSearchExpression(t *testing.T) {
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"oauth", `"oauth"*`},
+		{"oauth bug", `"oauth"* "bug"*`},
+		{`"login flow" fix`, `"login flow" "fix"*`},
+		{"oauth -bug", `"oauth"* NOT "bug"*`},
+		{`say "hi`, `"say"* "hi"`},
+		{`it's AND-or`, `"it's"* "AND-or"*`},
+		{"-", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			assert.Equal(t, tt.expected, searchExpression(tt.query))
+		})
+	}
+}
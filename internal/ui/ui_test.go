@@ -198,5 +198,7 @@ func TestConstants(t *testing.T) {
 		assert.NotEmpty(t, EmojiError)
 		assert.NotEmpty(t, EmojiWarning)
 		assert.NotEmpty(t, EmojiInfo)
+		assert.NotEmpty(t, EmojiPomodoro)
+		assert.NotEmpty(t, EmojiProject)
 	})
 }